@@ -28,6 +28,22 @@ func (v *BoolSchema[T]) setCoercer(c CoercerFunc) {
 	v.coercer = c
 }
 
+// Returns a deep copy of the schema. Used to clone inner schemas of complex schemas
+func (v *BoolSchema[T]) cloneSchema() ZogSchema {
+	return v.Clone()
+}
+
+// Returns the description of the schema. See z.Describe
+func (v *BoolSchema[T]) describe(d *describer) *SchemaDescription {
+	desc := d.new(v, v.getType())
+	desc.GoType = v.goType()
+	desc.Required = v.required != nil
+	desc.Default = derefValue(v.defaultVal)
+	desc.Catch = derefValue(v.catch)
+	desc.Tests = describeTests(v.processors)
+	return desc
+}
+
 // ! USER FACING FUNCTIONS
 
 // Returns a new Bool Shape
@@ -122,6 +138,22 @@ func (v *BoolSchema[T]) Transform(transform p.Transform[*T]) *BoolSchema[T] {
 	return v
 }
 
+// Returns a deep copy of the schema. Tests, transforms, required, default & catch values are copied so changing the copy doesn't affect the original
+func (v *BoolSchema[T]) Clone() *BoolSchema[T] {
+	return &BoolSchema[T]{
+		processors: p.CloneProcessors(v.processors),
+		defaultVal: clonePtr(v.defaultVal),
+		required:   v.required.Clone(),
+		catch:      clonePtr(v.catch),
+		coercer:    v.coercer,
+	}
+}
+
+// Returns a walkable description of the schema. See z.Describe
+func (v *BoolSchema[T]) Describe() *SchemaDescription {
+	return Describe(v)
+}
+
 // ! MODIFIERS
 // marks field as required
 func (v *BoolSchema[T]) Required(options ...TestOption) *BoolSchema[T] {
@@ -1,6 +1,8 @@
 package zog
 
 import (
+	"reflect"
+
 	"github.com/Oudwins/zog/conf"
 	p "github.com/Oudwins/zog/internals"
 	"github.com/Oudwins/zog/zconst"
@@ -135,3 +137,27 @@ func (s *BoxedSchema[B, T]) getType() zconst.ZogType {
 func (s *BoxedSchema[B, T]) setCoercer(c CoercerFunc) {
 	s.schema.setCoercer(c)
 }
+
+// Returns a deep copy of the schema. Used to clone inner schemas of complex schemas
+func (s *BoxedSchema[B, T]) cloneSchema() ZogSchema {
+	return s.Clone()
+}
+
+// Returns the description of the schema. See z.Describe
+func (s *BoxedSchema[B, T]) describe(d *describer) *SchemaDescription {
+	// copied so the box type doesn't leak into other uses of the boxed schema
+	desc := *d.schema(s.schema)
+	desc.BoxType = reflect.TypeOf((*B)(nil)).Elem()
+	d.seen[s] = &desc
+	return &desc
+}
+
+// Returns a deep copy of the schema and the schema it wraps
+func (s *BoxedSchema[B, T]) Clone() *BoxedSchema[B, T] {
+	return &BoxedSchema[B, T]{schema: s.schema.cloneSchema(), unbox: s.unbox, box: s.box}
+}
+
+// Returns a walkable description of the schema. See z.Describe
+func (s *BoxedSchema[B, T]) Describe() *SchemaDescription {
+	return Describe(s)
+}
@@ -0,0 +1,131 @@
+package zog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloneStringDoesNotAffectOriginal(t *testing.T) {
+	base := String().Min(3)
+	clone := base.Clone().Required().Max(5).Default("abc")
+
+	var out string
+	errs := base.Parse(nil, &out)
+	assert.Empty(t, errs)
+	assert.Equal(t, "", out)
+	errs = base.Parse("abcdefg", &out)
+	assert.Empty(t, errs)
+
+	errs = clone.Parse("abcdefg", &out)
+	assert.Len(t, errs, 1)
+	errs = clone.Parse(nil, &out)
+	assert.Empty(t, errs)
+	assert.Equal(t, "abc", out)
+}
+
+func TestCloneCopiesTests(t *testing.T) {
+	base := Int().GT(10)
+	clone := base.Clone()
+
+	var out int
+	errs := clone.Parse(5, &out)
+	assert.Len(t, errs, 1)
+	errs = base.Parse(5, &out)
+	assert.Len(t, errs, 1)
+}
+
+func TestClonePrimitives(t *testing.T) {
+	b := Bool()
+	assert.NotSame(t, b, b.Clone())
+	tm := Time().Required()
+	tmClone := tm.Clone().Optional()
+	var out time.Time
+	assert.Len(t, tm.Parse(nil, &out), 1)
+	assert.Empty(t, tmClone.Parse(nil, &out))
+}
+
+func TestCloneSliceDeep(t *testing.T) {
+	base := Slice(String())
+	clone := base.Clone()
+	clone.schema.(*StringSchema[string]).Min(3)
+
+	var out []string
+	errs := base.Parse([]string{"a"}, &out)
+	assert.Empty(t, errs)
+	errs = clone.Parse([]string{"a"}, &out)
+	assert.Len(t, errs, 1)
+}
+
+func TestClonePointerDeep(t *testing.T) {
+	base := Ptr(String())
+	clone := base.Clone()
+	clone.schema.(*StringSchema[string]).Min(3)
+
+	var out *string
+	errs := base.Parse("a", &out)
+	assert.Empty(t, errs)
+	errs = clone.Parse("a", &out)
+	assert.Len(t, errs, 1)
+}
+
+func TestCloneStructDeep(t *testing.T) {
+	type User struct {
+		Name string
+	}
+	base := Struct(Shape{
+		"name": String(),
+	})
+	clone := base.Clone()
+	clone.schema["name"].(*StringSchema[string]).Required()
+
+	var u User
+	errs := base.Parse(map[string]any{}, &u)
+	assert.Empty(t, errs)
+	errs = clone.Parse(map[string]any{}, &u)
+	assert.Len(t, errs, 1)
+}
+
+func TestCloneBoxedAndPreprocess(t *testing.T) {
+	boxed := Boxed(String(), func(b *string, ctx Ctx) (string, error) { return *b, nil }, func(s string, ctx Ctx) (*string, error) { return &s, nil })
+	boxedClone := boxed.Clone()
+	boxedClone.schema.(*StringSchema[string]).Min(3)
+	a := "a"
+	boxedOut := &a
+	assert.Empty(t, boxed.Validate(&boxedOut))
+	assert.Len(t, boxedClone.Validate(&boxedOut), 1)
+
+	pre := Preprocess(func(data any, ctx Ctx) (string, error) { return data.(string), nil }, String())
+	preClone := pre.Clone()
+	preClone.schema.(*StringSchema[string]).Min(3)
+	var out string
+	assert.Empty(t, pre.Parse("a", &out))
+	assert.Len(t, preClone.Parse("a", &out), 1)
+}
+
+func TestCloneDefaultAndCatchAreDeepCopied(t *testing.T) {
+	type Team struct {
+		Members []string
+	}
+	slice := Slice(String()).Default([]string{"a"}).Catch([]string{"b"})
+	sliceClone := slice.Clone()
+	sliceClone.defaultVal.([]string)[0] = "changed"
+	sliceClone.catch.([]string)[0] = "changed"
+	assert.Equal(t, []string{"a"}, slice.defaultVal)
+	assert.Equal(t, []string{"b"}, slice.catch)
+
+	m := Map(String(), Int()).Default(map[string]int{"a": 1})
+	mClone := m.Clone()
+	mClone.defaultVal.(map[string]int)["a"] = 2
+	var out map[string]int
+	assert.Empty(t, m.Parse(nil, &out))
+	assert.Equal(t, map[string]int{"a": 1}, out)
+
+	s := Struct(Shape{"members": Slice(String())}).Default(Team{Members: []string{"a"}}).Catch(Team{Members: []string{"b"}})
+	sClone := s.Clone()
+	sClone.defaultVal.(Team).Members[0] = "changed"
+	sClone.catch.(Team).Members[0] = "changed"
+	assert.Equal(t, Team{Members: []string{"a"}}, s.defaultVal)
+	assert.Equal(t, Team{Members: []string{"b"}}, s.catch)
+}
@@ -1,8 +1,10 @@
 package conf
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
+	"mime/multipart"
 	"reflect"
 	"strconv"
 	"time"
@@ -23,12 +25,32 @@ func TimeCoercerFactory(format func(data string) (time.Time, error)) CoercerFunc
 			return time.Unix(int64(v), 0), nil
 		case int64:
 			return time.Unix(v, 0), nil
+		case json.Number:
+			// unix timestamp in seconds, same as ints
+			i, err := strconv.ParseInt(v.String(), 10, 64)
+			if err != nil {
+				f, ok := wholeJSONNumber(v)
+				if !ok {
+					return nil, fmt.Errorf("failed to coerce json number to time.Time: %v", v)
+				}
+				i = int64(f)
+			}
+			return time.Unix(i, 0), nil
 		default:
 			return nil, fmt.Errorf("input data is an unsupported type to coerce to time.Time: %v", data)
 		}
 	}
 }
 
+// returns the value of json numbers written with a fraction or exponent (i.e 2.0 or 1e3) if it is a whole number float64 represents exactly
+func wholeJSONNumber(n json.Number) (float64, bool) {
+	f, err := n.Float64()
+	if err != nil || f != math.Trunc(f) || math.Abs(f) > 1<<53 {
+		return 0, false
+	}
+	return f, true
+}
+
 // takes in an original value and attempts to coerce it into another type. Returns an error if the coercion fails.
 type CoercerFunc = func(original any) (value any, err error)
 
@@ -41,6 +63,9 @@ var DefaultCoercers = struct {
 	Uint    CoercerFunc
 	Time    CoercerFunc
 	Slice   CoercerFunc
+	Map     CoercerFunc
+	Tuple   CoercerFunc
+	File    CoercerFunc
 }{
 	Bool: func(data any) (any, error) {
 		switch v := data.(type) {
@@ -66,6 +91,15 @@ var DefaultCoercers = struct {
 			} else if v == 1 {
 				return true, nil
 			}
+		case json.Number:
+			// same as ints, only 0 & 1 are accepted
+			f, ok := wholeJSONNumber(v)
+			if ok && f == 0 {
+				return false, nil
+			} else if ok && f == 1 {
+				return true, nil
+			}
+			return nil, fmt.Errorf("failed to coerce json number to bool: %v", v)
 		default:
 			return nil, fmt.Errorf("input data is an unsupported type to coerce to bool: %v", data)
 		}
@@ -76,6 +110,9 @@ var DefaultCoercers = struct {
 		switch v := data.(type) {
 		case string:
 			return v, nil
+		case json.Number:
+			// the number as it was written in the json
+			return v.String(), nil
 		default:
 			return fmt.Sprintf("%v", data), nil
 		}
@@ -130,6 +167,19 @@ var DefaultCoercers = struct {
 				return nil, fmt.Errorf("failed to coerce string int: %v", err)
 			}
 			return convVal, nil
+		case json.Number:
+			i, err := strconv.ParseInt(v.String(), 10, 64)
+			if err != nil {
+				f, ok := wholeJSONNumber(v)
+				if !ok {
+					return nil, fmt.Errorf("failed to coerce json number to int: %v", v)
+				}
+				i = int64(f)
+			}
+			if i > math.MaxInt || i < math.MinInt {
+				return nil, fmt.Errorf("json number %s overflows int", v)
+			}
+			return int(i), nil
 
 		case bool:
 			if v {
@@ -201,6 +251,19 @@ var DefaultCoercers = struct {
 				return nil, fmt.Errorf("failed to coerce string to uint: %v", err)
 			}
 			return uint(convVal), nil
+		case json.Number:
+			u, err := strconv.ParseUint(v.String(), 10, 64)
+			if err != nil {
+				f, ok := wholeJSONNumber(v)
+				if !ok || f < 0 {
+					return nil, fmt.Errorf("failed to coerce json number to uint: %v", v)
+				}
+				u = uint64(f)
+			}
+			if u > math.MaxUint {
+				return nil, fmt.Errorf("json number %s overflows uint", v)
+			}
+			return uint(u), nil
 		default:
 			return nil, fmt.Errorf("input data is an unsupported type to coerce to uint: %v", data)
 		}
@@ -213,6 +276,12 @@ var DefaultCoercers = struct {
 				return nil, fmt.Errorf("failed to coerce string to float64: %v", err)
 			}
 			return convVal, nil
+		case json.Number:
+			convVal, err := v.Float64()
+			if err != nil {
+				return nil, fmt.Errorf("failed to coerce json number to float64: %v", err)
+			}
+			return convVal, nil
 		case float64:
 			return v, nil
 		case float32:
@@ -254,6 +323,30 @@ var DefaultCoercers = struct {
 			return []any{data}, nil
 		}
 	},
+	Map: func(data any) (any, error) {
+		refVal := reflect.TypeOf(data)
+		if refVal == nil || refVal.Kind() != reflect.Map {
+			return nil, fmt.Errorf("input data is an unsupported type to coerce to map: %v", data)
+		}
+		return data, nil
+	},
+	Tuple: func(data any) (any, error) {
+		refVal := reflect.TypeOf(data)
+		if refVal == nil || (refVal.Kind() != reflect.Slice && refVal.Kind() != reflect.Array) {
+			return nil, fmt.Errorf("input data is an unsupported type to coerce to tuple: %v", data)
+		}
+		return data, nil
+	},
+	File: func(data any) (any, error) {
+		switch v := data.(type) {
+		case *multipart.FileHeader:
+			return v, nil
+		case multipart.FileHeader:
+			return &v, nil
+		default:
+			return nil, fmt.Errorf("input data is an unsupported type to coerce to *multipart.FileHeader: %v", data)
+		}
+	},
 }
 
 // Please override this variable instead of `DefaultCoercers` to add your own coercer functions.
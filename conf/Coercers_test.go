@@ -1,6 +1,7 @@
 package conf
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -31,6 +32,16 @@ func TestBoolCoercer(t *testing.T) {
 	b, err = Coercers.Bool(false)
 	assert.False(t, b.(bool))
 	assert.Nil(t, err)
+	b, err = Coercers.Bool(json.Number("1"))
+	assert.True(t, b.(bool))
+	assert.Nil(t, err)
+	b, err = Coercers.Bool(json.Number("0"))
+	assert.False(t, b.(bool))
+	assert.Nil(t, err)
+	_, err = Coercers.Bool(json.Number("2"))
+	assert.NotNil(t, err)
+	_, err = Coercers.Bool(json.Number("0.5"))
+	assert.NotNil(t, err)
 }
 
 func TestStringCoercer(t *testing.T) {
@@ -45,6 +56,7 @@ func TestStringCoercer(t *testing.T) {
 		{input: []any{"hello"}, want: "[hello]"},
 		{input: []int{1, 2, 3}, want: "[1 2 3]"},
 		{input: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), want: "2022-01-01 00:00:00 +0000 UTC"},
+		{input: json.Number("9007199254740993"), want: "9007199254740993"},
 	}
 	var s any
 	var err error
@@ -76,6 +88,12 @@ func TestIntCoercer(t *testing.T) {
 		{input: uint32(123), want: 123},
 		{input: uint16(123), want: 123},
 		{input: uint8(123), want: 123},
+		{input: json.Number("9007199254740993"), want: 9007199254740993},
+		{input: json.Number("-42"), want: -42},
+		{input: json.Number("2.0"), want: 2},
+		{input: json.Number("1e3"), want: 1000},
+		{input: json.Number("1.5"), err: true},
+		{input: json.Number("9223372036854775808"), err: true},
 	}
 	for _, test := range tests {
 		i, err = Coercers.Int(test.input)
@@ -113,6 +131,10 @@ func TestUintCoercer(t *testing.T) {
 		{input: uint(123), want: uint(123)},
 		{input: -123, err: true},
 		{input: "-123", err: true},
+		{input: json.Number("18446744073709551615"), want: uint(18446744073709551615)},
+		{input: json.Number("3.0"), want: uint(3)},
+		{input: json.Number("1.5"), err: true},
+		{input: json.Number("-1"), err: true},
 	}
 	for _, test := range tests {
 		u, err = Coercers.Uint(test.input)
@@ -137,6 +159,8 @@ func TestFloat64Coercer(t *testing.T) {
 		{input: "123", want: 123.00},
 		{input: 1.23, want: 1.23},
 		{input: "x", err: true},
+		{input: json.Number("1.5"), want: 1.5},
+		{input: json.Number("1e3"), want: 1000},
 	}
 
 	for _, test := range tests {
@@ -163,6 +187,9 @@ func TestTimeCoercer(t *testing.T) {
 		{input: "2024-09-09T00:00:00.000Z", want: time.Date(2024, 9, 9, 0, 0, 0, 0, time.UTC)},
 		{input: 1.23, err: true},
 		{input: 1733007600, want: time.Unix(1733007600, 0)},
+		{input: json.Number("1733007600"), want: time.Unix(1733007600, 0)},
+		{input: json.Number("1.7330076e9"), want: time.Unix(1733007600, 0)},
+		{input: json.Number("1.5"), err: true},
 	}
 
 	for _, test := range tests {
@@ -200,3 +227,28 @@ func TestSliceCoercer(t *testing.T) {
 		}
 	}
 }
+
+func TestMapCoercer(t *testing.T) {
+	var out any
+	var err error
+	tests := []struct {
+		input any
+		want  any
+		err   bool
+	}{
+		{input: map[string]any{"a": 1}, want: map[string]any{"a": 1}},
+		{input: map[int]string{1: "a"}, want: map[int]string{1: "a"}},
+		{input: "x", err: true},
+		{input: []any{"x"}, err: true},
+		{input: nil, err: true},
+	}
+	for _, test := range tests {
+		out, err = Coercers.Map(test.input)
+		if test.err {
+			assert.NotNil(t, err)
+		} else {
+			assert.Nil(t, err)
+			assert.Equal(t, test.want, out)
+		}
+	}
+}
@@ -91,6 +91,29 @@ func (c *Custom[T]) getType() zconst.ZogType {
 	return "custom"
 }
 
+// Returns a deep copy of the schema. Used to clone inner schemas of complex schemas
+func (c *Custom[T]) cloneSchema() ZogSchema {
+	return c.Clone()
+}
+
+// Returns the description of the schema. See z.Describe
+func (c *Custom[T]) describe(d *describer) *SchemaDescription {
+	desc := d.new(c, c.getType())
+	desc.GoType = c.goType()
+	desc.Tests = []TestDescription{describeTest(&c.test)}
+	return desc
+}
+
+// Returns a copy of the schema
+func (c *Custom[T]) Clone() *Custom[T] {
+	return &Custom[T]{test: *c.test.Clone()}
+}
+
+// Returns a walkable description of the schema. See z.Describe
+func (c *Custom[T]) Describe() *SchemaDescription {
+	return Describe(c)
+}
+
 // Experimental API. Expect breaking changes and no documentation unfortunately for now
 type EXPERIMENTAL_PUBLIC_ZOG_SCHEMA interface {
 	Process(ctx *p.SchemaCtx)
@@ -126,3 +149,18 @@ func (c *CustomSchema) getType() zconst.ZogType {
 func (c *CustomSchema) setCoercer(coercer CoercerFunc) {
 	c.schema.SetCoercer(coercer)
 }
+
+// The wrapped schema is user defined so it cannot be copied. The clone shares it with the original
+func (c *CustomSchema) cloneSchema() ZogSchema {
+	return &CustomSchema{schema: c.schema}
+}
+
+// Returns a walkable description of the schema. See z.Describe
+func (c *CustomSchema) Describe() *SchemaDescription {
+	return Describe(c)
+}
+
+// Returns the description of the schema. See z.Describe
+func (c *CustomSchema) describe(d *describer) *SchemaDescription {
+	return d.new(c, c.getType())
+}
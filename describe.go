@@ -0,0 +1,199 @@
+package zog
+
+import (
+	"maps"
+	"mime/multipart"
+	"reflect"
+	"sort"
+	"time"
+
+	p "github.com/Oudwins/zog/internals"
+	"github.com/Oudwins/zog/zconst"
+)
+
+// Description of a schema returned by z.Describe. It exposes what a schema checks so tooling (i.e exporters, doc generators or form builders) can be built on top of zog schemas.
+// Descriptions form a graph: describing the same schema twice in a tree returns the same pointer. This means recursive schemas (i.e z.Lazy) produce cycles, so walkers should keep track of the descriptions they already visited.
+type SchemaDescription struct {
+	// Zog type of the schema (i.e string, number, struct, ptr...). Schemas created with z.CustomFunc have the "custom" type
+	Type zconst.ZogType
+	// Go type the schema writes into. Only set for primitive & custom schemas
+	GoType reflect.Type
+	// Go type of the destination for z.Boxed schemas (i.e sql.NullString). The rest of the description is the one of the boxed schema
+	BoxType reflect.Type
+	// Whether the schema is marked as required (or NotNil for pointers)
+	Required bool
+	// Default value or nil if none was set
+	Default any
+	// Catch value or nil if none was set
+	Catch any
+	// Tests in the order they were added. Transforms are not included
+	Tests []TestDescription
+	// Struct fields keyed by the shape key. Only set for structs
+	Shape map[string]*SchemaDescription
+	// Schema for the map keys. Only set for maps
+	Key *SchemaDescription
+	// Inner schema. Items for slices, values for maps, pointed to schema for pointers & rest items for tuples
+	Schema *SchemaDescription
+	// Positional item schemas of a tuple in order
+	Items []*SchemaDescription
+	// Members of a union in order
+	Options []*SchemaDescription
+	// Discriminator key of a discriminated union
+	Discriminator string
+	// Variants of a discriminated union keyed by discriminator value
+	Variants map[string]*SchemaDescription
+	// Names accepted as input for the enum values keyed by name. Only set for enums with names (see EnumSchema.Names)
+	EnumNames map[string]any
+	// Shape key of the sibling field a z.When field depends on
+	Condition string
+	// Schemas used by a z.When field when the condition is true & false. nil if the field is skipped in that case or if the schema is only known during execution (z.WhenFunc)
+	Then      *SchemaDescription
+	Otherwise *SchemaDescription
+}
+
+// Description of a single test in a schema
+type TestDescription struct {
+	// Issue code of the test (i.e min, email, not_email). Tests created without an issue code are reported as custom
+	IssueCode zconst.ZogIssueCode
+	// Params of the test (i.e {"min": 3}). These are the same params used to format issue messages
+	Params map[string]any
+}
+
+// Returns a description of the schema. See SchemaDescription. Boxed, preprocess & lazy schemas are described as the schema they wrap (boxed descriptions also set BoxType).
+// Same as calling schema.Describe()
+func Describe(schema ZogSchema) *SchemaDescription {
+	d := &describer{seen: map[ZogSchema]*SchemaDescription{}}
+	return d.schema(schema)
+}
+
+// Same as Describe but for several schemas at once. The descriptions share one graph, so a schema used by more than one of them (i.e a struct schema nested in another) maps to the same description everywhere
+func DescribeAll(schemas ...ZogSchema) []*SchemaDescription {
+	d := &describer{seen: map[ZogSchema]*SchemaDescription{}}
+	descs := make([]*SchemaDescription, len(schemas))
+	for i, schema := range schemas {
+		descs[i] = d.schema(schema)
+	}
+	return descs
+}
+
+// Calls fn for the description and every description nested in it, depth first. Nested descriptions are visited in a stable order (map key, inner schema, tuple items, shape fields, union options, variants sorted by key & conditional schemas).
+// Each description is only visited once so recursive schemas don't loop forever. If fn returns false the descriptions nested in desc are skipped
+func (d *SchemaDescription) Walk(fn func(desc *SchemaDescription) bool) {
+	d.walk(fn, map[*SchemaDescription]bool{})
+}
+
+func (d *SchemaDescription) walk(fn func(desc *SchemaDescription) bool, visited map[*SchemaDescription]bool) {
+	if d == nil || visited[d] {
+		return
+	}
+	visited[d] = true
+	if !fn(d) {
+		return
+	}
+	d.Key.walk(fn, visited)
+	d.Schema.walk(fn, visited)
+	for _, item := range d.Items {
+		item.walk(fn, visited)
+	}
+	for _, k := range sortedKeys(d.Shape) {
+		d.Shape[k].walk(fn, visited)
+	}
+	for _, o := range d.Options {
+		o.walk(fn, visited)
+	}
+	for _, k := range sortedKeys(d.Variants) {
+		d.Variants[k].walk(fn, visited)
+	}
+	d.Then.walk(fn, visited)
+	d.Otherwise.walk(fn, visited)
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// keeps track of the descriptions already created so that the same schema always maps to the same description
+type describer struct {
+	seen map[ZogSchema]*SchemaDescription
+}
+
+func (d *describer) schema(s ZogSchema) *SchemaDescription {
+	if desc, ok := d.seen[s]; ok {
+		return desc
+	}
+	return s.describe(d)
+}
+
+// creates the description for the schema and registers it. Must be called before describing inner schemas
+func (d *describer) new(s ZogSchema, typ zconst.ZogType) *SchemaDescription {
+	desc := &SchemaDescription{Type: typ}
+	d.seen[s] = desc
+	return desc
+}
+
+// returns the description of every test in the processors. Transforms are skipped
+func describeTests[T any](processors []p.ZProcessor[T]) []TestDescription {
+	var tests []TestDescription
+	for _, processor := range processors {
+		if t, ok := processor.(*p.Test[T]); ok {
+			tests = append(tests, describeTest(t))
+		}
+	}
+	return tests
+}
+
+func describeTest[T any](t *p.Test[T]) TestDescription {
+	code := t.IssueCode
+	if code == "" {
+		code = zconst.IssueCodeCustom
+	}
+	// copied so changing the description doesn't change the schema
+	return TestDescription{IssueCode: code, Params: maps.Clone(t.Params)}
+}
+
+// returns the value the pointer points to or nil
+func derefValue[T any](v *T) any {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
+
+// Go types the schemas write into. Used to set SchemaDescription.GoType
+
+func (v *StringSchema[T]) goType() reflect.Type {
+	return reflect.TypeOf(*new(T))
+}
+
+func (v *NumberSchema[T]) goType() reflect.Type {
+	return reflect.TypeOf(*new(T))
+}
+
+func (v *EnumSchema[T]) goType() reflect.Type {
+	return reflect.TypeOf(*new(T))
+}
+
+func (v *LiteralSchema[T]) goType() reflect.Type {
+	return reflect.TypeOf(*new(T))
+}
+
+func (v *BoolSchema[T]) goType() reflect.Type {
+	return reflect.TypeOf(*new(T))
+}
+
+func (v *TimeSchema) goType() reflect.Type {
+	return reflect.TypeOf(time.Time{})
+}
+
+func (v *FileSchema) goType() reflect.Type {
+	return reflect.TypeOf((*multipart.FileHeader)(nil))
+}
+
+func (c *Custom[T]) goType() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
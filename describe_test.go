@@ -0,0 +1,147 @@
+package zog
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Oudwins/zog/zconst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribePrimitives(t *testing.T) {
+	desc := Describe(String().Min(3).Email().Required().Default("a@b.com"))
+	assert.Equal(t, zconst.TypeString, desc.Type)
+	assert.Equal(t, reflect.TypeOf(""), desc.GoType)
+	assert.True(t, desc.Required)
+	assert.Equal(t, "a@b.com", desc.Default)
+	assert.Equal(t, []TestDescription{
+		{IssueCode: zconst.IssueCodeMin, Params: map[string]any{zconst.IssueCodeMin: 3}},
+		{IssueCode: zconst.IssueCodeEmail},
+	}, desc.Tests)
+
+	desc = Describe(Int().GT(1).Not().OneOf([]int{5}))
+	assert.Equal(t, zconst.TypeNumber, desc.Type)
+	assert.Equal(t, reflect.TypeOf(0), desc.GoType)
+	assert.False(t, desc.Required)
+	assert.Nil(t, desc.Default)
+	assert.Equal(t, zconst.IssueCodeGT, desc.Tests[0].IssueCode)
+	assert.Equal(t, zconst.NotIssueCode(zconst.IssueCodeOneOf), desc.Tests[1].IssueCode)
+}
+
+func TestDescribeSkipsTransformsAndNamesCustomTests(t *testing.T) {
+	desc := Describe(String().Trim().TestFunc(func(val *string, ctx Ctx) bool { return true }))
+	assert.Len(t, desc.Tests, 1)
+	assert.Equal(t, zconst.IssueCodeCustom, desc.Tests[0].IssueCode)
+}
+
+func TestDescribeParamsAreCopied(t *testing.T) {
+	schema := String().Min(3)
+	desc := Describe(schema)
+	desc.Tests[0].Params[zconst.IssueCodeMin] = 10
+
+	var s string
+	errs := schema.Parse("abcd", &s)
+	assert.Empty(t, errs)
+	assert.Equal(t, 3, Describe(schema).Tests[0].Params[zconst.IssueCodeMin])
+}
+
+func TestDescribeComplex(t *testing.T) {
+	schema := Struct(Shape{
+		"name":  String().Required(),
+		"tags":  Slice(String()).Min(1),
+		"meta":  Map(String(), Int()),
+		"owner": Ptr(Struct(Shape{"id": Int()})).NotNil(),
+		"value": Union(String(), Int()),
+	})
+	desc := Describe(schema)
+	assert.Equal(t, zconst.TypeStruct, desc.Type)
+	assert.Len(t, desc.Shape, 5)
+	assert.True(t, desc.Shape["name"].Required)
+
+	tags := desc.Shape["tags"]
+	assert.Equal(t, zconst.TypeSlice, tags.Type)
+	assert.Equal(t, zconst.TypeString, tags.Schema.Type)
+	assert.Equal(t, zconst.IssueCodeMin, tags.Tests[0].IssueCode)
+
+	meta := desc.Shape["meta"]
+	assert.Equal(t, zconst.TypeString, meta.Key.Type)
+	assert.Equal(t, zconst.TypeNumber, meta.Schema.Type)
+
+	owner := desc.Shape["owner"]
+	assert.Equal(t, zconst.TypePtr, owner.Type)
+	assert.True(t, owner.Required)
+	assert.Equal(t, zconst.TypeStruct, owner.Schema.Type)
+
+	assert.Len(t, desc.Shape["value"].Options, 2)
+}
+
+func TestDescribeRecursive(t *testing.T) {
+	var comment *StructSchema
+	comment = Struct(Shape{
+		"text":    String(),
+		"replies": Slice(Lazy(func() ZogSchema { return comment })),
+	})
+	desc := Describe(comment)
+	assert.Same(t, desc, desc.Shape["replies"].Schema)
+}
+
+func TestDescribeAllSharesGraph(t *testing.T) {
+	address := Struct(Shape{"street": String()})
+	user := Struct(Shape{"address": Ptr(address)})
+	descs := DescribeAll(address, user)
+	assert.Len(t, descs, 2)
+	assert.Same(t, descs[0], descs[1].Shape["address"].Schema)
+}
+
+func TestDescribeBoxed(t *testing.T) {
+	type Box struct{ Value string }
+	inner := String().Required()
+	boxed := Boxed(inner, func(b Box, ctx Ctx) (string, error) { return b.Value, nil }, func(s string, ctx Ctx) (Box, error) { return Box{s}, nil })
+	descs := DescribeAll(inner, boxed)
+	assert.Equal(t, reflect.TypeOf(Box{}), descs[1].BoxType)
+	assert.Equal(t, reflect.TypeOf(""), descs[1].GoType)
+	assert.True(t, descs[1].Required)
+	assert.Nil(t, descs[0].BoxType)
+}
+
+func TestDescribeDiscriminatedUnion(t *testing.T) {
+	desc := Describe(DiscriminatedUnion("type", map[string]*StructSchema{
+		"card": Struct(Shape{"number": String()}),
+	}))
+	assert.Equal(t, "type", desc.Discriminator)
+	assert.Contains(t, desc.Variants["card"].Shape, "number")
+}
+
+func TestDescribeMethodAndCatch(t *testing.T) {
+	desc := String().Catch("fallback").Describe()
+	assert.Equal(t, "fallback", desc.Catch)
+
+	type User struct{ Name string }
+	var schema ZogSchema = Struct(Shape{"name": String()}).Catch(User{Name: "zog"})
+	desc = schema.Describe()
+	assert.Equal(t, User{Name: "zog"}, desc.Catch)
+	assert.Nil(t, desc.Shape["name"].Catch)
+}
+
+func TestDescriptionWalk(t *testing.T) {
+	var comment *StructSchema
+	comment = Struct(Shape{
+		"text":    String(),
+		"tags":    Map(String(), Int()),
+		"replies": Slice(Lazy(func() ZogSchema { return comment })),
+	})
+
+	var types []string
+	comment.Describe().Walk(func(desc *SchemaDescription) bool {
+		types = append(types, desc.Type)
+		return true
+	})
+	assert.Equal(t, []string{"struct", "slice", "map", "string", "number", "string"}, types)
+
+	types = nil
+	comment.Describe().Walk(func(desc *SchemaDescription) bool {
+		types = append(types, desc.Type)
+		return desc.Type != zconst.TypeStruct
+	})
+	assert.Equal(t, []string{"struct"}, types)
+}
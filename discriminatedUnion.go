@@ -0,0 +1,334 @@
+package zog
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"sort"
+
+	"github.com/Oudwins/zog/conf"
+	p "github.com/Oudwins/zog/internals"
+	"github.com/Oudwins/zog/zconst"
+)
+
+var _ ComplexZogSchema = &DiscriminatedUnionSchema{}
+
+type DiscriminatedUnionSchema struct {
+	discriminator string
+	variants      map[string]*StructSchema
+	constructors  map[string]func() any
+	// type returned by each constructor. Used to find the variant of interface destinations without calling the constructors
+	types    map[string]reflect.Type
+	options  []string
+	required *p.Test[any]
+}
+
+// Returns the type of the schema
+func (v *DiscriminatedUnionSchema) getType() zconst.ZogType {
+	return zconst.TypeStruct
+}
+
+// Sets the coercer for the schema
+func (v *DiscriminatedUnionSchema) setCoercer(c CoercerFunc) {
+	// noop
+}
+
+// Returns a deep copy of the schema. Used to clone inner schemas of complex schemas
+func (v *DiscriminatedUnionSchema) cloneSchema() ZogSchema {
+	return v.Clone()
+}
+
+// Returns the description of the schema. See z.Describe
+func (v *DiscriminatedUnionSchema) describe(d *describer) *SchemaDescription {
+	desc := d.new(v, v.getType())
+	desc.Required = v.required != nil
+	desc.Discriminator = v.discriminator
+	desc.Variants = make(map[string]*SchemaDescription, len(v.variants))
+	for k, s := range v.variants {
+		desc.Variants[k] = d.schema(s)
+	}
+	return desc
+}
+
+// ! USER FACING FUNCTIONS
+
+// Creates a discriminated union schema. Similar to Zod's `z.discriminatedUnion()`.
+// The value of the discriminator key in the input data selects which struct schema is used. Usage:
+//
+//	z.DiscriminatedUnion("type", map[string]*z.StructSchema{
+//		"card": z.Struct(z.Shape{"number": z.String().Required()}),
+//		"bank": z.Struct(z.Shape{"iban": z.String().Required()}),
+//	}).Constructor("card", func() any { return &Card{} }).Constructor("bank", func() any { return &Bank{} })
+//
+// Constructors are only required when parsing into an interface destination.
+// Inputs without the discriminator are skipped unless the schema is marked as Required.
+func DiscriminatedUnion(discriminator string, variants map[string]*StructSchema) *DiscriminatedUnionSchema {
+	options := make([]string, 0, len(variants))
+	for k := range variants {
+		options = append(options, k)
+	}
+	sort.Strings(options)
+	return &DiscriminatedUnionSchema{
+		discriminator: discriminator,
+		variants:      variants,
+		constructors:  make(map[string]func() any, len(variants)),
+		types:         make(map[string]reflect.Type, len(variants)),
+		options:       options,
+	}
+}
+
+// Registers the constructor for a variant. The constructor must return a pointer to a new struct for that variant.
+// It is used to create the value stored in interface destinations. The pointer is stored if it implements the interface, otherwise the struct is.
+// fn is called once when it is registered to record the type of the variant, which Validate uses to match interface destinations
+func (v *DiscriminatedUnionSchema) Constructor(value string, fn func() any) *DiscriminatedUnionSchema {
+	v.constructors[value] = fn
+	v.types[value] = reflect.TypeOf(fn())
+	return v
+}
+
+// marks the discriminator as required. Inputs without it raise a required issue at the discriminator path
+func (v *DiscriminatedUnionSchema) Required(options ...TestOption) *DiscriminatedUnionSchema {
+	r := p.Required[any]()
+	for _, opt := range options {
+		opt(&r)
+	}
+	v.required = &r
+	return v
+}
+
+// marks the discriminator as optional. Inputs without it are skipped. This is the default
+func (v *DiscriminatedUnionSchema) Optional() *DiscriminatedUnionSchema {
+	v.required = nil
+	return v
+}
+
+// Returns a deep copy of the schema and every variant
+func (v *DiscriminatedUnionSchema) Clone() *DiscriminatedUnionSchema {
+	variants := make(map[string]*StructSchema, len(v.variants))
+	for k, s := range v.variants {
+		variants[k] = s.Clone()
+	}
+	return &DiscriminatedUnionSchema{
+		discriminator: v.discriminator,
+		variants:      variants,
+		constructors:  maps.Clone(v.constructors),
+		types:         maps.Clone(v.types),
+		options:       slices.Clone(v.options),
+		required:      v.required.Clone(),
+	}
+}
+
+// Returns a walkable description of the schema. See z.Describe
+func (v *DiscriminatedUnionSchema) Describe() *SchemaDescription {
+	return Describe(v)
+}
+
+// Parses the data into the destination. dest can be a pointer to a struct or a pointer to an interface
+func (v *DiscriminatedUnionSchema) Parse(data any, dest any, options ...ExecOption) ZogIssueList {
+	errs := p.NewErrsList()
+	defer errs.Free()
+	ctx := p.NewExecCtx(errs, conf.IssueFormatter)
+	defer ctx.Free()
+	for _, opt := range options {
+		opt(ctx)
+	}
+	path := p.NewPathBuilder()
+	defer path.Free()
+	sctx := ctx.NewSchemaCtx(data, dest, path, v.getType())
+	defer sctx.Free()
+	v.process(sctx)
+
+	return errs.List
+}
+
+// Internal function to process the data
+func (v *DiscriminatedUnionSchema) process(ctx *p.SchemaCtx) {
+	data := ctx.Data
+	if factory, ok := data.(p.DpFactory); ok {
+		newDp, err := factory()
+		if err != nil {
+			ctx.AddIssue(ctx.IssueFromUnknownError(err))
+			return
+		}
+		data = newDp
+	}
+	// values that are not objects (i.e json arrays) fail to convert
+	dataProv, err := p.TryNewAnyDataProvider(data)
+	if err != nil {
+		ctx.AddIssue(ctx.IssueFromCoerce(err))
+		return
+	}
+
+	tag := dataProv.Get(v.discriminator)
+	if p.IsParseZeroValue(tag, ctx) {
+		v.addRequiredIssue(ctx, tag)
+		return
+	}
+	variant, key, ok := v.variant(tag)
+	if !ok {
+		v.addDiscriminatorIssue(ctx, tag)
+		return
+	}
+
+	destVal := reflect.ValueOf(ctx.ValPtr)
+	if destVal.Kind() != reflect.Pointer {
+		p.Panicf(p.PanicInvalidArgumentsExpectedPointer)
+	}
+	subCtx := ctx.NewSchemaCtx(dataProv, ctx.ValPtr, ctx.Path, variant.getType())
+	defer subCtx.Free()
+	if destVal.Elem().Kind() != reflect.Interface {
+		variant.process(subCtx)
+		return
+	}
+
+	obj := v.construct(ctx, key)
+	subCtx.ValPtr = obj.Interface()
+	variant.process(subCtx)
+	v.store(destVal.Elem(), obj)
+}
+
+// Validates a pointer to a struct or a pointer to an interface holding one of the variants
+func (v *DiscriminatedUnionSchema) Validate(dataPtr any, options ...ExecOption) ZogIssueList {
+	errs := p.NewErrsList()
+	defer errs.Free()
+	ctx := p.NewExecCtx(errs, conf.IssueFormatter)
+	defer ctx.Free()
+	for _, opt := range options {
+		opt(ctx)
+	}
+	path := p.NewPathBuilder()
+	defer path.Free()
+	sctx := ctx.NewSchemaCtx(dataPtr, dataPtr, path, v.getType())
+	defer sctx.Free()
+	v.validate(sctx)
+
+	return errs.List
+}
+
+// Internal function to validate the data
+func (v *DiscriminatedUnionSchema) validate(ctx *p.SchemaCtx) {
+	destVal := reflect.ValueOf(ctx.ValPtr)
+	if destVal.Kind() != reflect.Pointer {
+		p.Panicf(p.PanicInvalidArgumentsExpectedPointer)
+	}
+
+	// Interface destinations are matched against the type returned by each constructor
+	if destVal.Elem().Kind() == reflect.Interface {
+		inner := destVal.Elem().Elem()
+		if !inner.IsValid() {
+			v.addRequiredIssue(ctx, nil)
+			return
+		}
+		ptr := inner
+		if inner.Kind() != reflect.Pointer {
+			ptr = reflect.New(inner.Type())
+			ptr.Elem().Set(inner)
+		}
+		for _, key := range v.options {
+			if v.types[key] != ptr.Type() {
+				continue
+			}
+			subCtx := ctx.NewValidateSchemaCtx(ptr.Interface(), ctx.Path, v.variants[key].getType())
+			v.variants[key].validate(subCtx)
+			subCtx.Free()
+			if inner.Kind() != reflect.Pointer {
+				destVal.Elem().Set(ptr.Elem())
+			}
+			return
+		}
+		v.addDiscriminatorIssue(ctx, nil)
+		return
+	}
+
+	// Struct destinations carry the discriminator as a field
+	tag := discriminatorField(destVal.Elem(), v.discriminator)
+	if p.IsZeroValue(tag) {
+		v.addRequiredIssue(ctx, tag)
+		return
+	}
+	variant, _, ok := v.variant(tag)
+	if !ok {
+		v.addDiscriminatorIssue(ctx, tag)
+		return
+	}
+	subCtx := ctx.NewValidateSchemaCtx(ctx.ValPtr, ctx.Path, variant.getType())
+	defer subCtx.Free()
+	variant.validate(subCtx)
+}
+
+// returns the variant for the discriminator value
+func (v *DiscriminatedUnionSchema) variant(tag any) (*StructSchema, string, bool) {
+	if tag == nil {
+		return nil, "", false
+	}
+	s, err := conf.Coercers.String(tag)
+	if err != nil {
+		return nil, "", false
+	}
+	key := s.(string)
+	variant, ok := v.variants[key]
+	return variant, key, ok
+}
+
+func (v *DiscriminatedUnionSchema) addDiscriminatorIssue(ctx *p.SchemaCtx, tag any) {
+	ctx.Path.Push(&v.discriminator)
+	ctx.AddIssue(ctx.Issue().SetCode(zconst.IssueCodeInvalidDiscriminator).SetValue(tag).SetParams(map[string]any{
+		zconst.IssueCodeInvalidDiscriminator: v.options,
+	}))
+	ctx.Path.Pop()
+}
+
+// adds the required issue at the discriminator path if the schema is required. Missing discriminators of optional schemas are skipped
+func (v *DiscriminatedUnionSchema) addRequiredIssue(ctx *p.SchemaCtx, tag any) {
+	if v.required == nil {
+		return
+	}
+	ctx.Path.Push(&v.discriminator)
+	ctx.AddIssue(ctx.IssueFromTest(v.required, tag))
+	ctx.Path.Pop()
+}
+
+// calls the registered constructor for the variant and returns the pointer it created
+func (v *DiscriminatedUnionSchema) construct(ctx *p.SchemaCtx, key string) reflect.Value {
+	ctor, ok := v.constructors[key]
+	if !ok {
+		p.Panicf(p.PanicMissingDiscriminatorConstructor, ctx.String(), key)
+	}
+	obj := reflect.ValueOf(ctor())
+	if obj.Kind() != reflect.Pointer || obj.IsNil() {
+		p.Panicf(p.PanicMissingDiscriminatorConstructor, ctx.String(), key)
+	}
+	return obj
+}
+
+// stores the constructed pointer (or the value it points to) into the interface destination
+func (v *DiscriminatedUnionSchema) store(dest reflect.Value, obj reflect.Value) {
+	if obj.Type().AssignableTo(dest.Type()) {
+		dest.Set(obj)
+		return
+	}
+	dest.Set(obj.Elem())
+}
+
+// reads the discriminator value from a struct. Looks for a field tagged with the discriminator or named like it
+func discriminatorField(structVal reflect.Value, discriminator string) any {
+	if structVal.Kind() != reflect.Struct {
+		return nil
+	}
+	typ := structVal.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		if tag, ok := typ.Field(i).Tag.Lookup(zconst.ZogTag); ok && tag == discriminator {
+			return structVal.Field(i).Interface()
+		}
+	}
+	key := discriminator
+	if key != "" && key[0] >= 'a' && key[0] <= 'z' {
+		key = fmt.Sprintf("%c%s", key[0]-32, key[1:])
+	}
+	field := structVal.FieldByName(key)
+	if !field.IsValid() {
+		return nil
+	}
+	return field.Interface()
+}
@@ -0,0 +1,193 @@
+package zog
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Oudwins/zog/parsers/zjson"
+	"github.com/Oudwins/zog/tutils"
+	"github.com/Oudwins/zog/zconst"
+	"github.com/stretchr/testify/assert"
+)
+
+type PaymentMethod interface {
+	isPaymentMethod()
+}
+
+type CardPayment struct {
+	Type   string
+	Number string
+}
+
+func (c *CardPayment) isPaymentMethod() {}
+
+type BankPayment struct {
+	Type string
+	Iban string
+}
+
+func (b *BankPayment) isPaymentMethod() {}
+
+func paymentSchema() *DiscriminatedUnionSchema {
+	return DiscriminatedUnion("type", map[string]*StructSchema{
+		"card": Struct(Shape{
+			"type":   String(),
+			"number": String().Required().Len(4),
+		}),
+		"bank": Struct(Shape{
+			"type": String(),
+			"iban": String().Required(),
+		}),
+	}).
+		Constructor("card", func() any { return &CardPayment{} }).
+		Constructor("bank", func() any { return &BankPayment{} })
+}
+
+func TestDiscriminatedUnionParseInterface(t *testing.T) {
+	schema := paymentSchema()
+	var dest PaymentMethod
+	errs := schema.Parse(map[string]any{"type": "card", "number": "1234"}, &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, &CardPayment{Type: "card", Number: "1234"}, dest)
+
+	errs = schema.Parse(zjson.Decode(strings.NewReader(`{"type": "bank", "iban": "ES12"}`)), &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, &BankPayment{Type: "bank", Iban: "ES12"}, dest)
+}
+
+func TestDiscriminatedUnionParseVariantIssues(t *testing.T) {
+	schema := paymentSchema()
+	var dest PaymentMethod
+	errs := schema.Parse(map[string]any{"type": "card", "number": "12"}, &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "number", errs[0].PathString())
+	assert.Equal(t, zconst.IssueCodeLen, errs[0].Code)
+}
+
+func TestDiscriminatedUnionParseUnknownDiscriminator(t *testing.T) {
+	schema := paymentSchema()
+	var dest PaymentMethod
+	errs := schema.Parse(map[string]any{"type": "cash"}, &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeInvalidDiscriminator, errs[0].Code)
+	assert.Equal(t, []string{"type"}, errs[0].Path)
+	assert.Equal(t, "cash", errs[0].Value)
+	assert.Equal(t, []string{"bank", "card"}, errs[0].Params[zconst.IssueCodeInvalidDiscriminator])
+	assert.Equal(t, "must be one of [bank card]", errs[0].Message)
+	assert.Nil(t, dest)
+	tutils.VerifyDefaultIssueMessages(t, errs)
+
+}
+
+func TestDiscriminatedUnionParseMissingDiscriminator(t *testing.T) {
+	var dest PaymentMethod
+	errs := paymentSchema().Parse(map[string]any{"number": "1234"}, &dest)
+	assert.Empty(t, errs)
+	assert.Nil(t, dest)
+
+	errs = paymentSchema().Parse(nil, &dest)
+	assert.Empty(t, errs)
+
+	schema := paymentSchema().Required(Message("pick a payment method"))
+	errs = schema.Parse(map[string]any{"number": "1234"}, &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeRequired, errs[0].Code)
+	assert.Equal(t, []string{"type"}, errs[0].Path)
+	assert.Equal(t, "pick a payment method", errs[0].Message)
+	assert.True(t, schema.Describe().Required)
+
+	errs = schema.Clone().Optional().Parse(map[string]any{"number": "1234"}, &dest)
+	assert.Empty(t, errs)
+}
+
+func TestDiscriminatedUnionParseStructDest(t *testing.T) {
+	schema := paymentSchema()
+	var dest CardPayment
+	errs := schema.Parse(map[string]any{"type": "card", "number": "1234"}, &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, CardPayment{Type: "card", Number: "1234"}, dest)
+}
+
+func TestDiscriminatedUnionInStruct(t *testing.T) {
+	type Order struct {
+		Payment PaymentMethod
+	}
+	schema := Struct(Shape{
+		"payment": paymentSchema(),
+	})
+	var dest Order
+	errs := schema.Parse(map[string]any{
+		"payment": map[string]any{"type": "bank"},
+	}, &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "payment.iban", errs[0].PathString())
+
+	errs = schema.Parse(map[string]any{
+		"payment": map[string]any{"type": "nope"},
+	}, &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "payment.type", errs[0].PathString())
+}
+
+func TestDiscriminatedUnionMissingConstructorPanics(t *testing.T) {
+	schema := DiscriminatedUnion("type", map[string]*StructSchema{
+		"card": Struct(Shape{"number": String()}),
+	})
+	var dest PaymentMethod
+	assert.Panics(t, func() {
+		schema.Parse(map[string]any{"type": "card"}, &dest)
+	})
+}
+
+func TestDiscriminatedUnionValidate(t *testing.T) {
+	schema := paymentSchema()
+	var dest PaymentMethod = &CardPayment{Type: "card", Number: "12"}
+	errs := schema.Validate(&dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "number", errs[0].PathString())
+
+	dest = &BankPayment{Type: "bank", Iban: "ES12"}
+	errs = schema.Validate(&dest)
+	assert.Empty(t, errs)
+
+	var empty PaymentMethod
+	errs = schema.Validate(&empty)
+	assert.Empty(t, errs)
+
+	errs = schema.Required().Validate(&empty)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeRequired, errs[0].Code)
+	tutils.VerifyDefaultIssueMessages(t, errs)
+
+	// types that are not a variant still raise the discriminator issue
+	var other PaymentMethod = &otherPayment{}
+	errs = schema.Validate(&other)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeInvalidDiscriminator, errs[0].Code)
+}
+
+type otherPayment struct{}
+
+func (o *otherPayment) isPaymentMethod() {}
+
+func TestDiscriminatedUnionValidateStruct(t *testing.T) {
+	schema := paymentSchema()
+	dest := BankPayment{Type: "bank"}
+	errs := schema.Validate(&dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "iban", errs[0].PathString())
+
+	dest = BankPayment{Iban: "ES12"}
+	errs = schema.Validate(&dest)
+	assert.Empty(t, errs)
+	errs = schema.Required().Validate(&dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "type", errs[0].PathString())
+	assert.Equal(t, zconst.IssueCodeRequired, errs[0].Code)
+
+	dest = BankPayment{Type: "other", Iban: "ES12"}
+	errs = schema.Validate(&dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeInvalidDiscriminator, errs[0].Code)
+	assert.Equal(t, "other", errs[0].Value)
+}
@@ -0,0 +1,281 @@
+package zog
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+
+	"github.com/Oudwins/zog/conf"
+	p "github.com/Oudwins/zog/internals"
+	"github.com/Oudwins/zog/zconst"
+)
+
+var _ ZogSchema = &EnumSchema[string]{}
+
+// Types with a Values method returning every valid value can be used with z.Enum without listing the values. Usage:
+//
+//	type Status string
+//	func (Status) Values() []Status { return []Status{StatusActive, StatusInactive} }
+//	z.Enum[Status]()
+type EnumValuer[T any] interface {
+	Values() []T
+}
+
+type EnumSchema[T comparable] struct {
+	values     []T
+	names      map[string]T
+	processors []p.ZProcessor[*T]
+	defaultVal *T
+	required   *p.Test[*T]
+	coercer    CoercerFunc
+}
+
+// ! INTERNALS
+
+// Returns the type of the schema
+func (v *EnumSchema[T]) getType() zconst.ZogType {
+	return zconst.TypeEnum
+}
+
+// Sets the coercer for the schema
+func (v *EnumSchema[T]) setCoercer(c CoercerFunc) {
+	v.coercer = c
+}
+
+// Returns a deep copy of the schema. Used to clone inner schemas of complex schemas
+func (v *EnumSchema[T]) cloneSchema() ZogSchema {
+	return v.Clone()
+}
+
+// Returns the description of the schema. See z.Describe. The values are described as a one_of_options test
+func (v *EnumSchema[T]) describe(d *describer) *SchemaDescription {
+	desc := d.new(v, v.getType())
+	desc.GoType = v.goType()
+	desc.Required = v.required != nil
+	desc.Default = derefValue(v.defaultVal)
+	desc.Tests = describeTests(v.processors)
+	if len(v.names) > 0 {
+		desc.EnumNames = make(map[string]any, len(v.names))
+		for name, val := range v.names {
+			desc.EnumNames[name] = val
+		}
+	}
+	return desc
+}
+
+// ! USER FACING FUNCTIONS
+
+// Creates an enum schema. The value must be one of values. If no values are passed and T implements z.EnumValuer the values returned by T.Values() are used. Usage:
+//
+//	type Status string
+//	const (
+//		StatusActive   Status = "active"
+//		StatusInactive Status = "inactive"
+//	)
+//	z.Enum(StatusActive, StatusInactive)
+//
+// The input is coerced to T (i.e json numbers to int based enums). Use Names to accept names as input for integer based enums.
+// Inputs that can't be coerced or are not one of the values raise a zconst.IssueCodeOneOf issue.
+// Panics if there are no values
+func Enum[T comparable](values ...T) *EnumSchema[T] {
+	if len(values) == 0 {
+		if valuer, ok := any(*new(T)).(EnumValuer[T]); ok {
+			values = valuer.Values()
+		} else if valuer, ok := any(new(T)).(EnumValuer[T]); ok {
+			values = valuer.Values()
+		}
+	}
+	if len(values) == 0 {
+		p.Panicf(p.PanicEnumDefinition, reflect.TypeOf(*new(T)), "An enum needs at least one value. Pass the values to z.Enum or implement z.EnumValuer")
+	}
+	values = slices.Clone(values)
+	t, fn := p.In(values)
+	p.TestFuncFromBool(fn, &t)
+	return &EnumSchema[T]{
+		values:     values,
+		processors: []p.ZProcessor[*T]{&t},
+		coercer:    kindCoercer[T](),
+	}
+}
+
+// Returns the values of the enum
+func (v *EnumSchema[T]) Values() []T {
+	return slices.Clone(v.values)
+}
+
+// Parse data into destination pointer
+func (v *EnumSchema[T]) Parse(data any, dest *T, options ...ExecOption) ZogIssueList {
+	errs := p.NewErrsList()
+	defer errs.Free()
+	ctx := p.NewExecCtx(errs, conf.IssueFormatter)
+	defer ctx.Free()
+	for _, opt := range options {
+		opt(ctx)
+	}
+	path := p.NewPathBuilder()
+	defer path.Free()
+	sctx := ctx.NewSchemaCtx(data, dest, path, v.getType())
+	defer sctx.Free()
+	v.process(sctx)
+	return errs.List
+}
+
+// Internal function to process the data
+func (v *EnumSchema[T]) process(ctx *p.SchemaCtx) {
+	destPtr, ok := ctx.ValPtr.(*T)
+	if !ok {
+		p.Panicf(p.PanicTypeCast, ctx.String(), ctx.DType, ctx.ValPtr)
+	}
+	if !resolveDataProvider(ctx) {
+		return
+	}
+
+	if p.IsParseZeroValue(ctx.Data, ctx) {
+		if v.defaultVal != nil {
+			*destPtr = *v.defaultVal
+		} else if v.required != nil {
+			ctx.AddIssue(ctx.IssueFromTest(v.required, *destPtr))
+			return
+		} else {
+			return
+		}
+	} else {
+		val, err := v.coerce(ctx.Data)
+		if err != nil {
+			// the one_of_options issue is more useful than a coerce issue since it lists the valid values
+			ctx.Processor = v.processors[0]
+			ctx.AddIssue(ctx.IssueFromTest(v.processors[0].(p.TestInterface), ctx.Data).SetError(err))
+			return
+		}
+		*destPtr = val
+	}
+	v.runProcessors(ctx, destPtr)
+}
+
+// resolves names before coercing the data to T
+func (v *EnumSchema[T]) coerce(data any) (T, error) {
+	if name, ok := data.(string); ok {
+		if val, ok := v.names[name]; ok {
+			return val, nil
+		}
+	}
+	x, err := v.coercer(data)
+	if err != nil {
+		return *new(T), err
+	}
+	val, ok := x.(T)
+	if !ok {
+		return *new(T), fmt.Errorf("coercer returned %T, expected %T", x, val)
+	}
+	return val, nil
+}
+
+// Validate data against schema
+func (v *EnumSchema[T]) Validate(val *T, options ...ExecOption) ZogIssueList {
+	errs := p.NewErrsList()
+	defer errs.Free()
+	ctx := p.NewExecCtx(errs, conf.IssueFormatter)
+	defer ctx.Free()
+	for _, opt := range options {
+		opt(ctx)
+	}
+	path := p.NewPathBuilder()
+	defer path.Free()
+	sctx := ctx.NewSchemaCtx(val, val, path, v.getType())
+	defer sctx.Free()
+	v.validate(sctx)
+	return errs.List
+}
+
+// Internal function to validate data
+func (v *EnumSchema[T]) validate(ctx *p.SchemaCtx) {
+	valPtr, ok := ctx.ValPtr.(*T)
+	if !ok {
+		p.Panicf(p.PanicTypeCast, ctx.String(), ctx.DType, ctx.ValPtr)
+	}
+	// zero values are missing values unless they are one of the values (i.e iota based enums)
+	if p.IsZeroValue(*valPtr) && !slices.Contains(v.values, *valPtr) {
+		if v.defaultVal != nil {
+			*valPtr = *v.defaultVal
+		} else if v.required != nil {
+			ctx.AddIssue(ctx.IssueFromTest(v.required, *valPtr))
+			return
+		} else {
+			return
+		}
+	}
+	v.runProcessors(ctx, valPtr)
+}
+
+func (v *EnumSchema[T]) runProcessors(ctx *p.SchemaCtx, valPtr *T) {
+	for _, processor := range v.processors {
+		ctx.Processor = processor
+		processor.ZProcess(valPtr, ctx)
+		if ctx.Exit {
+			return
+		}
+	}
+}
+
+// Returns a deep copy of the schema. Tests, names & required are copied so changing the copy doesn't affect the original
+func (v *EnumSchema[T]) Clone() *EnumSchema[T] {
+	return &EnumSchema[T]{
+		values:     slices.Clone(v.values),
+		names:      maps.Clone(v.names),
+		processors: p.CloneProcessors(v.processors),
+		defaultVal: clonePtr(v.defaultVal),
+		required:   v.required.Clone(),
+		coercer:    v.coercer,
+	}
+}
+
+// Returns a walkable description of the schema. See z.Describe
+func (v *EnumSchema[T]) Describe() *SchemaDescription {
+	return Describe(v)
+}
+
+// !MODIFIERS
+
+// Accepts the names as input for their values. Useful for integer based enums. Usage:
+//
+//	type Status int
+//	const (
+//		StatusActive Status = iota + 1
+//		StatusInactive
+//	)
+//	z.Enum(StatusActive, StatusInactive).Names(map[string]Status{"ACTIVE": StatusActive, "INACTIVE": StatusInactive})
+//
+// Values are still accepted as input. Panics if a name maps to a value that is not in the enum
+func (v *EnumSchema[T]) Names(names map[string]T) *EnumSchema[T] {
+	for name, val := range names {
+		if !slices.Contains(v.values, val) {
+			p.Panicf(p.PanicEnumDefinition, reflect.TypeOf(*new(T)), fmt.Sprintf("Name %s maps to %v which is not one of the enum values", name, val))
+		}
+	}
+	// copied so changing the map after doesn't change the schema
+	v.names = maps.Clone(names)
+	return v
+}
+
+// marks field as required
+func (v *EnumSchema[T]) Required(options ...TestOption) *EnumSchema[T] {
+	r := p.Required[*T]()
+	for _, opt := range options {
+		opt(&r)
+	}
+	v.required = &r
+	return v
+}
+
+// marks field as optional
+func (v *EnumSchema[T]) Optional() *EnumSchema[T] {
+	v.required = nil
+	return v
+}
+
+// sets the default value
+func (v *EnumSchema[T]) Default(val T) *EnumSchema[T] {
+	v.defaultVal = &val
+	return v
+}
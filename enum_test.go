@@ -0,0 +1,172 @@
+package zog
+
+import (
+	"testing"
+
+	"github.com/Oudwins/zog/tutils"
+	"github.com/Oudwins/zog/zconst"
+	"github.com/stretchr/testify/assert"
+)
+
+type enumStatus string
+
+const (
+	enumStatusActive   enumStatus = "active"
+	enumStatusInactive enumStatus = "inactive"
+)
+
+func (enumStatus) Values() []enumStatus {
+	return []enumStatus{enumStatusActive, enumStatusInactive}
+}
+
+type enumLevel int
+
+const (
+	enumLevelLow enumLevel = iota
+	enumLevelHigh
+)
+
+func TestEnumParse(t *testing.T) {
+	var status enumStatus
+	errs := Enum(enumStatusActive, enumStatusInactive).Parse("inactive", &status)
+	assert.Empty(t, errs)
+	assert.Equal(t, enumStatusInactive, status)
+
+	errs = Enum(enumStatusActive, enumStatusInactive).Parse("deleted", &status)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeOneOf, errs[0].Code)
+	assert.Equal(t, zconst.TypeEnum, errs[0].Dtype)
+	assert.Equal(t, []enumStatus{enumStatusActive, enumStatusInactive}, errs[0].Params[zconst.IssueCodeOneOf])
+	tutils.VerifyDefaultIssueMessages(t, errs)
+
+	var level enumLevel
+	errs = Enum(enumLevelLow, enumLevelHigh).Parse(float64(1), &level)
+	assert.Empty(t, errs)
+	assert.Equal(t, enumLevelHigh, level)
+
+	// inputs that can't be coerced are reported as one_of_options issues
+	errs = Enum(enumLevelLow, enumLevelHigh).Parse("HIGH", &level)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeOneOf, errs[0].Code)
+	assert.NotNil(t, errs[0].Err)
+
+	// values that overflow the enum type don't wrap around
+	var small int8
+	errs = Enum(int8(1), int8(2)).Parse(257, &small)
+	assert.Len(t, errs, 1)
+	assert.NotNil(t, errs[0].Err)
+	assert.Equal(t, int8(0), small)
+}
+
+func TestEnumValuer(t *testing.T) {
+	schema := Enum[enumStatus]()
+	assert.Equal(t, []enumStatus{enumStatusActive, enumStatusInactive}, schema.Values())
+
+	var status enumStatus
+	errs := schema.Parse("active", &status)
+	assert.Empty(t, errs)
+	assert.Equal(t, enumStatusActive, status)
+}
+
+func TestEnumNames(t *testing.T) {
+	schema := Enum(enumLevelLow, enumLevelHigh).Names(map[string]enumLevel{"LOW": enumLevelLow, "HIGH": enumLevelHigh})
+	var level enumLevel
+	errs := schema.Parse("HIGH", &level)
+	assert.Empty(t, errs)
+	assert.Equal(t, enumLevelHigh, level)
+
+	// values are still accepted
+	errs = schema.Parse("0", &level)
+	assert.Empty(t, errs)
+	assert.Equal(t, enumLevelLow, level)
+
+	errs = schema.Parse("MEDIUM", &level)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeOneOf, errs[0].Code)
+}
+
+func TestEnumNamesAreCopied(t *testing.T) {
+	names := map[string]enumLevel{"LOW": enumLevelLow}
+	schema := Enum(enumLevelLow, enumLevelHigh).Names(names)
+	names["HIGH"] = enumLevelHigh
+	var level enumLevel
+	errs := schema.Parse("HIGH", &level)
+	assert.Len(t, errs, 1)
+
+	clone := schema.Clone().Names(map[string]enumLevel{"HIGH": enumLevelHigh}).Default(enumLevelHigh)
+	errs = clone.Parse("HIGH", &level)
+	assert.Empty(t, errs)
+	errs = schema.Parse("LOW", &level)
+	assert.Empty(t, errs)
+	assert.Nil(t, schema.Describe().Default)
+}
+
+func TestEnumRequiredAndDefault(t *testing.T) {
+	var status enumStatus
+	errs := Enum[enumStatus]().Parse(nil, &status)
+	assert.Empty(t, errs)
+	assert.Empty(t, status)
+
+	errs = Enum[enumStatus]().Required().Parse(nil, &status)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeRequired, errs[0].Code)
+	tutils.VerifyDefaultIssueMessages(t, errs)
+
+	errs = Enum[enumStatus]().Default(enumStatusActive).Parse(nil, &status)
+	assert.Empty(t, errs)
+	assert.Equal(t, enumStatusActive, status)
+}
+
+func TestEnumValidate(t *testing.T) {
+	status := enumStatus("deleted")
+	errs := Enum[enumStatus]().Validate(&status)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeOneOf, errs[0].Code)
+
+	status = ""
+	errs = Enum[enumStatus]().Required().Validate(&status)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeRequired, errs[0].Code)
+
+	// the zero value is valid if it is one of the values
+	level := enumLevelLow
+	errs = Enum(enumLevelLow, enumLevelHigh).Required().Validate(&level)
+	assert.Empty(t, errs)
+}
+
+func TestEnumInStruct(t *testing.T) {
+	type Account struct {
+		Status enumStatus
+		Level  enumLevel
+	}
+	schema := Struct(Shape{
+		"status": Enum[enumStatus]().Required(),
+		"level":  Enum(enumLevelLow, enumLevelHigh).Names(map[string]enumLevel{"LOW": enumLevelLow, "HIGH": enumLevelHigh}),
+	})
+	var dest Account
+	errs := schema.Parse(map[string]any{"status": "active", "level": "HIGH"}, &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, Account{Status: enumStatusActive, Level: enumLevelHigh}, dest)
+}
+
+func TestEnumDescribeAndClone(t *testing.T) {
+	schema := Enum(enumLevelLow, enumLevelHigh).Names(map[string]enumLevel{"HIGH": enumLevelHigh}).Required()
+	desc := schema.Describe()
+	assert.Equal(t, zconst.TypeEnum, desc.Type)
+	assert.True(t, desc.Required)
+	assert.Equal(t, []enumLevel{enumLevelLow, enumLevelHigh}, desc.Tests[0].Params[zconst.IssueCodeOneOf])
+	assert.Equal(t, map[string]any{"HIGH": enumLevelHigh}, desc.EnumNames)
+
+	clone := schema.Clone().Optional()
+	assert.False(t, clone.Describe().Required)
+	assert.True(t, schema.Describe().Required)
+}
+
+func TestEnumPanics(t *testing.T) {
+	assert.Panics(t, func() {
+		Enum[enumLevel]()
+	})
+	assert.Panics(t, func() {
+		Enum(enumLevelLow).Names(map[string]enumLevel{"HIGH": enumLevelHigh})
+	})
+}
@@ -0,0 +1,308 @@
+package zog
+
+import (
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/Oudwins/zog/conf"
+	p "github.com/Oudwins/zog/internals"
+	"github.com/Oudwins/zog/zconst"
+)
+
+var _ ZogSchema = &FileSchema{}
+
+type FileSchema struct {
+	processors []p.ZProcessor[**multipart.FileHeader]
+	required   *p.Test[**multipart.FileHeader]
+	coercer    CoercerFunc
+}
+
+// ! INTERNALS
+
+// Returns the type of the schema
+func (v *FileSchema) getType() zconst.ZogType {
+	return zconst.TypeFile
+}
+
+// Sets the coercer for the schema
+func (v *FileSchema) setCoercer(c CoercerFunc) {
+	v.coercer = c
+}
+
+// Returns a deep copy of the schema. Used to clone inner schemas of complex schemas
+func (v *FileSchema) cloneSchema() ZogSchema {
+	return v.Clone()
+}
+
+// Returns the description of the schema. See z.Describe
+func (v *FileSchema) describe(d *describer) *SchemaDescription {
+	desc := d.new(v, v.getType())
+	desc.GoType = v.goType()
+	desc.Required = v.required != nil
+	desc.Tests = describeTests(v.processors)
+	return desc
+}
+
+// ! USER FACING FUNCTIONS
+
+// Creates a file schema. It parses uploaded files into a *multipart.FileHeader. Use z.Slice(z.File()) for fields with multiple files. Usage:
+//
+//	type Upload struct {
+//		Avatar *multipart.FileHeader `form:"avatar"`
+//	}
+//	schema := z.Struct(z.Shape{
+//		"avatar": z.File().Required().MaxSize(2 << 20).MimeType([]string{"image/png", "image/jpeg"}),
+//	})
+//	errs := schema.Parse(zhttp.Request(r), &upload)
+func File(opts ...SchemaOption) *FileSchema {
+	s := &FileSchema{
+		coercer: conf.Coercers.File,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Parse data into destination pointer
+func (v *FileSchema) Parse(data any, dest **multipart.FileHeader, options ...ExecOption) ZogIssueList {
+	errs := p.NewErrsList()
+	defer errs.Free()
+	ctx := p.NewExecCtx(errs, conf.IssueFormatter)
+	defer ctx.Free()
+	for _, opt := range options {
+		opt(ctx)
+	}
+	path := p.NewPathBuilder()
+	defer path.Free()
+	sctx := ctx.NewSchemaCtx(data, dest, path, v.getType())
+	defer sctx.Free()
+	v.process(sctx)
+	return errs.List
+}
+
+// Internal function to process the data
+func (v *FileSchema) process(ctx *p.SchemaCtx) {
+	destPtr, ok := ctx.ValPtr.(**multipart.FileHeader)
+	if !ok {
+		p.Panicf(p.PanicTypeCast, ctx.String(), ctx.DType, ctx.ValPtr)
+	}
+	if !resolveDataProvider(ctx) {
+		return
+	}
+
+	if p.IsParseZeroValue(ctx.Data, ctx) {
+		if v.required != nil {
+			ctx.AddIssue(ctx.IssueFromTest(v.required, *destPtr))
+		}
+		return
+	}
+	x, err := v.coercer(ctx.Data)
+	if err != nil {
+		ctx.AddIssue(ctx.IssueFromCoerce(err))
+		return
+	}
+	fh, ok := x.(*multipart.FileHeader)
+	if !ok {
+		p.Panicf(p.PanicTypeCastCoercer, ctx.String(), ctx.DType, x)
+	}
+	*destPtr = fh
+	v.runProcessors(ctx, destPtr)
+}
+
+// Validate data against schema
+func (v *FileSchema) Validate(val **multipart.FileHeader, options ...ExecOption) ZogIssueList {
+	errs := p.NewErrsList()
+	defer errs.Free()
+	ctx := p.NewExecCtx(errs, conf.IssueFormatter)
+	defer ctx.Free()
+	for _, opt := range options {
+		opt(ctx)
+	}
+	path := p.NewPathBuilder()
+	defer path.Free()
+	sctx := ctx.NewSchemaCtx(val, val, path, v.getType())
+	defer sctx.Free()
+	v.validate(sctx)
+	return errs.List
+}
+
+// Internal function to validate data
+func (v *FileSchema) validate(ctx *p.SchemaCtx) {
+	valPtr, ok := ctx.ValPtr.(**multipart.FileHeader)
+	if !ok {
+		p.Panicf(p.PanicTypeCast, ctx.String(), ctx.DType, ctx.ValPtr)
+	}
+	if *valPtr == nil {
+		if v.required != nil {
+			ctx.AddIssue(ctx.IssueFromTest(v.required, *valPtr))
+		}
+		return
+	}
+	v.runProcessors(ctx, valPtr)
+}
+
+func (v *FileSchema) runProcessors(ctx *p.SchemaCtx, valPtr **multipart.FileHeader) {
+	for _, processor := range v.processors {
+		ctx.Processor = processor
+		processor.ZProcess(valPtr, ctx)
+		if ctx.Exit {
+			return
+		}
+	}
+}
+
+// Returns a deep copy of the schema. Tests, transforms & required are copied so changing the copy doesn't affect the original
+func (v *FileSchema) Clone() *FileSchema {
+	return &FileSchema{
+		processors: p.CloneProcessors(v.processors),
+		required:   v.required.Clone(),
+		coercer:    v.coercer,
+	}
+}
+
+// Returns a walkable description of the schema. See z.Describe
+func (v *FileSchema) Describe() *SchemaDescription {
+	return Describe(v)
+}
+
+// !MODIFIERS
+
+// marks field as required
+func (v *FileSchema) Required(options ...TestOption) *FileSchema {
+	r := p.Required[**multipart.FileHeader]()
+	for _, opt := range options {
+		opt(&r)
+	}
+	v.required = &r
+	return v
+}
+
+// marks field as optional
+func (v *FileSchema) Optional() *FileSchema {
+	v.required = nil
+	return v
+}
+
+// Adds transform function to schema.
+func (v *FileSchema) Transform(transform Transform[**multipart.FileHeader]) *FileSchema {
+	v.processors = append(v.processors, &p.TransformProcessor[**multipart.FileHeader]{
+		Transform: p.Transform[**multipart.FileHeader](transform),
+	})
+	return v
+}
+
+// !TESTS
+
+// custom test function call it -> schema.Test(t z.Test)
+func (v *FileSchema) Test(t Test[**multipart.FileHeader]) *FileSchema {
+	x := p.Test[**multipart.FileHeader](t)
+	v.processors = append(v.processors, &x)
+	return v
+}
+
+// Create a custom test function for the schema. This is similar to Zod's `.refine()` method.
+func (v *FileSchema) TestFunc(testFunc BoolTFunc[**multipart.FileHeader], opts ...TestOption) *FileSchema {
+	t := p.NewTestFunc("", p.BoolTFunc[**multipart.FileHeader](testFunc), opts...)
+	v.Test(Test[**multipart.FileHeader](*t))
+	return v
+}
+
+// Minimum size of the file in bytes
+func (v *FileSchema) MinSize(n int64, options ...TestOption) *FileSchema {
+	fn := func(val **multipart.FileHeader, ctx Ctx) bool {
+		return (*val).Size >= n
+	}
+	t := &p.Test[**multipart.FileHeader]{
+		IssueCode: zconst.IssueCodeMin,
+		Params:    map[string]any{zconst.IssueCodeMin: n},
+	}
+	return v.addTest(t, fn, options...)
+}
+
+// Maximum size of the file in bytes
+func (v *FileSchema) MaxSize(n int64, options ...TestOption) *FileSchema {
+	fn := func(val **multipart.FileHeader, ctx Ctx) bool {
+		return (*val).Size <= n
+	}
+	t := &p.Test[**multipart.FileHeader]{
+		IssueCode: zconst.IssueCodeMax,
+		Params:    map[string]any{zconst.IssueCodeMax: n},
+	}
+	return v.addTest(t, fn, options...)
+}
+
+// The type of the file must be one of types. i.e image/png or image/* for any image.
+// The type is sniffed from the first 512 bytes of the content with http.DetectContentType, the Content-Type sent by the client is ignored.
+// Empty files have no type to sniff so they pass. Use MinSize(1) to reject them
+func (v *FileSchema) MimeType(types []string, options ...TestOption) *FileSchema {
+	fn := func(val **multipart.FileHeader, ctx Ctx) bool {
+		if (*val).Size == 0 {
+			return true
+		}
+		detected, ok := sniffMimeType(*val)
+		if !ok {
+			return false
+		}
+		for _, typ := range types {
+			if typ == detected || (strings.HasSuffix(typ, "/*") && strings.HasPrefix(detected, typ[:len(typ)-1])) {
+				return true
+			}
+		}
+		return false
+	}
+	t := &p.Test[**multipart.FileHeader]{
+		IssueCode: zconst.IssueCodeMimeType,
+		Params:    map[string]any{zconst.IssueCodeMimeType: types},
+	}
+	return v.addTest(t, fn, options...)
+}
+
+// The file name must have one of the extensions. The comparison is case insensitive & the leading dot is optional. i.e []string{".png", "jpg"}
+func (v *FileSchema) Extension(extensions []string, options ...TestOption) *FileSchema {
+	fn := func(val **multipart.FileHeader, ctx Ctx) bool {
+		ext := strings.TrimPrefix(filepath.Ext((*val).Filename), ".")
+		for _, e := range extensions {
+			if strings.EqualFold(ext, strings.TrimPrefix(e, ".")) {
+				return true
+			}
+		}
+		return false
+	}
+	t := &p.Test[**multipart.FileHeader]{
+		IssueCode: zconst.IssueCodeExtension,
+		Params:    map[string]any{zconst.IssueCodeExtension: extensions},
+	}
+	return v.addTest(t, fn, options...)
+}
+
+// returns the media type (without params) of the file content
+func sniffMimeType(fh *multipart.FileHeader) (string, bool) {
+	f, err := fh.Open()
+	if err != nil {
+		return "", false
+	}
+	defer f.Close()
+	buf := make([]byte, 512)
+	n, err := f.Read(buf)
+	if err != nil && n == 0 {
+		return "", false
+	}
+	mediaType, _, err := mime.ParseMediaType(http.DetectContentType(buf[:n]))
+	if err != nil {
+		return "", false
+	}
+	return mediaType, true
+}
+
+func (v *FileSchema) addTest(t *p.Test[**multipart.FileHeader], fn p.BoolTFunc[**multipart.FileHeader], options ...TestOption) *FileSchema {
+	p.TestFuncFromBool(fn, t)
+	for _, opt := range options {
+		opt(t)
+	}
+	v.processors = append(v.processors, t)
+	return v
+}
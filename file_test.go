@@ -0,0 +1,183 @@
+package zog
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"testing"
+
+	"github.com/Oudwins/zog/tutils"
+	"github.com/Oudwins/zog/zconst"
+	"github.com/stretchr/testify/assert"
+)
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+// builds the file headers of a multipart form with one file per name
+func uploadFiles(t *testing.T, files map[string][]byte) map[string][]*multipart.FileHeader {
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+	for name, content := range files {
+		part, err := w.CreateFormFile("files", name)
+		assert.NoError(t, err)
+		_, err = part.Write(content)
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, w.Close())
+
+	r, err := http.NewRequest("POST", "/", body)
+	assert.NoError(t, err)
+	r.Header.Set("Content-Type", w.FormDataContentType())
+	assert.NoError(t, r.ParseMultipartForm(1<<20))
+	byName := map[string][]*multipart.FileHeader{}
+	for _, fh := range r.MultipartForm.File["files"] {
+		byName[fh.Filename] = append(byName[fh.Filename], fh)
+	}
+	return byName
+}
+
+func TestFileParse(t *testing.T) {
+	files := uploadFiles(t, map[string][]byte{"avatar.png": pngHeader})
+	var dest *multipart.FileHeader
+	errs := File().Parse(files["avatar.png"][0], &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, "avatar.png", dest.Filename)
+
+	errs = File().Parse("avatar.png", &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeCoerce, errs[0].Code)
+	tutils.VerifyDefaultIssueMessages(t, errs)
+}
+
+func TestFileRequired(t *testing.T) {
+	var dest *multipart.FileHeader
+	errs := File().Parse(nil, &dest)
+	assert.Empty(t, errs)
+	assert.Nil(t, dest)
+
+	errs = File().Required().Parse(nil, &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeRequired, errs[0].Code)
+	assert.Equal(t, zconst.TypeFile, errs[0].Dtype)
+	tutils.VerifyDefaultIssueMessages(t, errs)
+
+	errs = File().Required().Validate(&dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeRequired, errs[0].Code)
+}
+
+func TestFileSize(t *testing.T) {
+	files := uploadFiles(t, map[string][]byte{"a.txt": []byte("hello world")})
+	fh := files["a.txt"][0]
+
+	errs := File().MinSize(5).MaxSize(11).Validate(&fh)
+	assert.Empty(t, errs)
+
+	errs = File().MaxSize(5).Validate(&fh)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeMax, errs[0].Code)
+	assert.Equal(t, int64(5), errs[0].Params[zconst.IssueCodeMax])
+	tutils.VerifyDefaultIssueMessages(t, errs)
+
+	errs = File().MinSize(12).Validate(&fh)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeMin, errs[0].Code)
+	tutils.VerifyDefaultIssueMessages(t, errs)
+}
+
+func TestFileMimeType(t *testing.T) {
+	// the file name & Content-Type sent by the client are ignored
+	files := uploadFiles(t, map[string][]byte{"image.png": []byte("<html><body></body></html>"), "real.txt": pngHeader})
+
+	var dest *multipart.FileHeader
+	errs := File().MimeType([]string{"image/png"}).Parse(files["real.txt"][0], &dest)
+	assert.Empty(t, errs)
+
+	errs = File().MimeType([]string{"image/*"}).Parse(files["real.txt"][0], &dest)
+	assert.Empty(t, errs)
+
+	// params like charset are stripped before comparing
+	errs = File().MimeType([]string{"text/html"}).Parse(files["image.png"][0], &dest)
+	assert.Empty(t, errs)
+
+	errs = File().MimeType([]string{"image/png", "image/jpeg"}).Parse(files["image.png"][0], &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeMimeType, errs[0].Code)
+	assert.Equal(t, []string{"image/png", "image/jpeg"}, errs[0].Params[zconst.IssueCodeMimeType])
+	tutils.VerifyDefaultIssueMessages(t, errs)
+}
+
+func TestFileMimeTypeEmptyFile(t *testing.T) {
+	files := uploadFiles(t, map[string][]byte{"empty.png": {}})
+	fh := files["empty.png"][0]
+
+	// empty files are left to MinSize instead of failing with a misleading mime type issue
+	errs := File().MimeType([]string{"image/png"}).Validate(&fh)
+	assert.Empty(t, errs)
+
+	errs = File().MinSize(1).MimeType([]string{"image/png"}).Validate(&fh)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeMin, errs[0].Code)
+}
+
+func TestFileExtension(t *testing.T) {
+	files := uploadFiles(t, map[string][]byte{"photo.JPG": pngHeader, "notes.txt": []byte("notes")})
+
+	var dest *multipart.FileHeader
+	errs := File().Extension([]string{".png", "jpg"}).Parse(files["photo.JPG"][0], &dest)
+	assert.Empty(t, errs)
+
+	errs = File().Extension([]string{".png", "jpg"}).Parse(files["notes.txt"][0], &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeExtension, errs[0].Code)
+	tutils.VerifyDefaultIssueMessages(t, errs)
+}
+
+func TestFileSliceInStruct(t *testing.T) {
+	files := uploadFiles(t, map[string][]byte{"a.png": pngHeader, "b.png": pngHeader, "c.txt": []byte("c")})
+	type Upload struct {
+		Avatar      *multipart.FileHeader
+		Attachments []*multipart.FileHeader
+	}
+	schema := Struct(Shape{
+		"avatar":      File().Required(),
+		"attachments": Slice(File().MimeType([]string{"image/png"})).Max(3),
+	})
+
+	var dest Upload
+	errs := schema.Parse(map[string]any{
+		"avatar":      files["a.png"][0],
+		"attachments": []*multipart.FileHeader{files["a.png"][0], files["b.png"][0]},
+	}, &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, "a.png", dest.Avatar.Filename)
+	assert.Len(t, dest.Attachments, 2)
+
+	// a single file is parsed as a slice with one item
+	dest = Upload{}
+	errs = schema.Parse(map[string]any{"avatar": files["a.png"][0], "attachments": files["b.png"][0]}, &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, "b.png", dest.Attachments[0].Filename)
+
+	errs = schema.Parse(map[string]any{
+		"attachments": []*multipart.FileHeader{files["a.png"][0], files["c.txt"][0]},
+	}, &dest)
+	assert.Len(t, errs, 2)
+	assert.Equal(t, "avatar", errs[0].PathString())
+	assert.Equal(t, zconst.IssueCodeRequired, errs[0].Code)
+	assert.Equal(t, "attachments[1]", errs[1].PathString())
+	assert.Equal(t, zconst.IssueCodeMimeType, errs[1].Code)
+}
+
+func TestFileDescribeAndClone(t *testing.T) {
+	schema := File().Required().MaxSize(10)
+	desc := schema.Describe()
+	assert.Equal(t, zconst.TypeFile, desc.Type)
+	assert.True(t, desc.Required)
+	assert.Equal(t, zconst.IssueCodeMax, desc.Tests[0].IssueCode)
+
+	clone := schema.Clone().Optional().MinSize(1)
+	assert.False(t, clone.Describe().Required)
+	assert.Len(t, clone.Describe().Tests, 2)
+	assert.Len(t, schema.Describe().Tests, 1)
+}
@@ -0,0 +1,395 @@
+package zog
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	p "github.com/Oudwins/zog/internals"
+	"github.com/Oudwins/zog/zconst"
+)
+
+// cache of the struct schemas built from struct tags. reflect.Type -> *StructSchema
+var fromStructCache sync.Map
+
+// only one FromStruct build can run at a time so recursive types are resolved against a consistent cache
+var fromStructMu sync.Mutex
+
+// Creates a struct schema from the `z` struct tags of T. Similar to go-playground/validator. Usage:
+//
+//	type User struct {
+//		Email string   `zog:"email" z:"required,email,max=255"`
+//		Age   int      `z:"gte=18"`
+//		Tags  []string `z:"max=5,dive,min=2"`
+//	}
+//	var userSchema = z.FromStruct[User]()
+//
+// Every exported field is added to the shape, the shape key is the field name with the first letter lowercased. Use `z:"-"` to skip a field.
+// Rules are comma separated and use the existing schema builders. The schema for a type is built once and cached, every call returns a clone of it so it can be modified safely.
+// Panics if T is not a struct, if a rule is unknown or its param is invalid and if a field type is not supported.
+func FromStruct[T any]() *StructSchema {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	if typ.Kind() != reflect.Struct {
+		p.Panicf(p.PanicStructTagDefinition, typ.String(), "", "FromStruct expects a struct type")
+	}
+	if s, ok := fromStructCache.Load(typ); ok {
+		return s.(*StructSchema).Clone()
+	}
+
+	fromStructMu.Lock()
+	defer fromStructMu.Unlock()
+	b := &tagBuilder{building: map[reflect.Type]*StructSchema{}}
+	return b.structSchema(typ).Clone()
+}
+
+type tagBuilder struct {
+	// struct schemas currently being built. Used to break cycles in recursive types
+	building map[reflect.Type]*StructSchema
+}
+
+// a single rule in a `z` tag. i.e max=255
+type tagRule struct {
+	name  string
+	param string
+}
+
+// the field we are building the schema for. Used for panic messages
+type tagField struct {
+	structType reflect.Type
+	name       string
+}
+
+func (f tagField) panicf(format string, args ...any) {
+	p.Panicf(p.PanicStructTagDefinition, f.structType.String(), f.name, fmt.Sprintf(format, args...))
+}
+
+// returns the param of the rule or panics if it is empty
+func (f tagField) param(r tagRule) string {
+	if r.param == "" {
+		f.panicf("rule %s requires a param. i.e %s=10", r.name, r.name)
+	}
+	return r.param
+}
+
+func (f tagField) int(r tagRule) int {
+	n, err := strconv.Atoi(f.param(r))
+	if err != nil {
+		f.panicf("rule %s expects an integer param, got %q", r.name, r.param)
+	}
+	return n
+}
+
+func (b *tagBuilder) structSchema(typ reflect.Type) *StructSchema {
+	if s, ok := fromStructCache.Load(typ); ok {
+		return s.(*StructSchema)
+	}
+	if s, ok := b.building[typ]; ok {
+		return s
+	}
+
+	s := Struct(Shape{})
+	b.building[typ] = s
+	b.addFields(s.schema, typ, typ)
+	delete(b.building, typ)
+	fromStructCache.Store(typ, s)
+	return s
+}
+
+// adds the exported fields of typ to the shape. Embedded structs are flattened since their fields are promoted
+func (b *tagBuilder) addFields(shape Shape, root reflect.Type, typ reflect.Type) {
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		tag := field.Tag.Get(zconst.RulesTag)
+		if tag == "-" {
+			continue
+		}
+		if field.Anonymous && field.Type.Kind() == reflect.Struct && tag == "" {
+			b.addFields(shape, root, field.Type)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		f := tagField{structType: root, name: field.Name}
+		key := strings.ToLower(field.Name[:1]) + field.Name[1:]
+		shape[key] = b.schema(field.Type, parseTagRules(tag), f)
+	}
+}
+
+func parseTagRules(tag string) []tagRule {
+	if tag == "" {
+		return nil
+	}
+	parts := strings.Split(tag, ",")
+	rules := make([]tagRule, 0, len(parts))
+	for _, part := range parts {
+		name, param, _ := strings.Cut(strings.TrimSpace(part), "=")
+		if name == "" {
+			continue
+		}
+		rules = append(rules, tagRule{name: name, param: param})
+	}
+	return rules
+}
+
+// splits the rules at the dive rule. The rules after it apply to the elements of slices & maps
+func splitDive(rules []tagRule) ([]tagRule, []tagRule) {
+	for i, r := range rules {
+		if r.name == "dive" {
+			return rules[:i], rules[i+1:]
+		}
+	}
+	return rules, nil
+}
+
+// returns the required rule (if present) and the rest of the rules
+func takeRequired(rules []tagRule) (bool, []tagRule) {
+	rest := make([]tagRule, 0, len(rules))
+	required := false
+	for _, r := range rules {
+		if r.name == "required" {
+			required = true
+			continue
+		}
+		rest = append(rest, r)
+	}
+	return required, rest
+}
+
+func (b *tagBuilder) schema(typ reflect.Type, rules []tagRule, f tagField) ZogSchema {
+	if typ == reflect.TypeOf(time.Time{}) {
+		return timeFromTag(Time(), rules, f)
+	}
+
+	switch typ.Kind() {
+	case reflect.Pointer:
+		// required on a pointer means not nil. The rest apply to the value it points to
+		required, rest := takeRequired(rules)
+		s := Ptr(b.schema(typ.Elem(), rest, f))
+		if required {
+			s.NotNil()
+		}
+		return s
+	case reflect.Slice:
+		outer, inner := splitDive(rules)
+		s := Slice(b.schema(typ.Elem(), inner, f))
+		for _, r := range outer {
+			switch r.name {
+			case "required":
+				s.Required()
+			case "omitempty":
+			case "min":
+				s.Min(f.int(r))
+			case "max":
+				s.Max(f.int(r))
+			case "len":
+				s.Len(f.int(r))
+			default:
+				f.panicf("unknown rule %s for slices", r.name)
+			}
+		}
+		return s
+	case reflect.Map:
+		outer, inner := splitDive(rules)
+		s := Map(b.schema(typ.Key(), nil, f), b.schema(typ.Elem(), inner, f))
+		for _, r := range outer {
+			switch r.name {
+			case "required":
+				s.Required()
+			case "omitempty":
+			case "min":
+				s.Min(f.int(r))
+			case "max":
+				s.Max(f.int(r))
+			case "len":
+				s.Len(f.int(r))
+			default:
+				f.panicf("unknown rule %s for maps", r.name)
+			}
+		}
+		return s
+	case reflect.Struct:
+		for _, r := range rules {
+			switch r.name {
+			case "omitempty":
+			case "required":
+				// struct values are never missing. Required only makes sense on pointers, where it means not nil
+				f.panicf("rule required is not supported for struct values. Use a pointer field (*%s) to require the struct", typ.String())
+			default:
+				f.panicf("unknown rule %s for structs", r.name)
+			}
+		}
+		s := b.structSchema(typ)
+		if _, inProgress := b.building[typ]; inProgress {
+			// recursive types reference the struct schema lazily since it is not finished yet
+			return Lazy(func() ZogSchema { return s })
+		}
+		return s
+	}
+
+	// primitives must be the builtin types since the schema is generic over the destination type
+	switch typ {
+	case reflect.TypeOf(""):
+		return stringFromTag(String(), rules, f)
+	case reflect.TypeOf(false):
+		return boolFromTag(Bool(), rules, f)
+	case reflect.TypeOf(int(0)):
+		return numberFromTag(Int(), rules, f)
+	case reflect.TypeOf(int8(0)):
+		return numberFromTag(IntLike[int8](), rules, f)
+	case reflect.TypeOf(int16(0)):
+		return numberFromTag(IntLike[int16](), rules, f)
+	case reflect.TypeOf(int32(0)):
+		return numberFromTag(Int32(), rules, f)
+	case reflect.TypeOf(int64(0)):
+		return numberFromTag(Int64(), rules, f)
+	case reflect.TypeOf(uint(0)):
+		return numberFromTag(Uint(), rules, f)
+	case reflect.TypeOf(uint8(0)):
+		return numberFromTag(UintLike[uint8](), rules, f)
+	case reflect.TypeOf(uint16(0)):
+		return numberFromTag(UintLike[uint16](), rules, f)
+	case reflect.TypeOf(uint32(0)):
+		return numberFromTag(UintLike[uint32](), rules, f)
+	case reflect.TypeOf(uint64(0)):
+		return numberFromTag(UintLike[uint64](), rules, f)
+	case reflect.TypeOf(float32(0)):
+		return numberFromTag(Float32(), rules, f)
+	case reflect.TypeOf(float64(0)):
+		return numberFromTag(Float64(), rules, f)
+	}
+
+	f.panicf("unsupported type %s. Define the schema for this field manually or skip it with `z:\"-\"`", typ.String())
+	return nil
+}
+
+func stringFromTag(s *StringSchema[string], rules []tagRule, f tagField) *StringSchema[string] {
+	for _, r := range rules {
+		switch r.name {
+		case "required":
+			s.Required()
+		case "omitempty":
+		case "default":
+			s.Default(r.param)
+		case "min":
+			s.Min(f.int(r))
+		case "max":
+			s.Max(f.int(r))
+		case "len":
+			s.Len(f.int(r))
+		case "oneof":
+			s.OneOf(strings.Fields(f.param(r)))
+		case "email":
+			s.Email()
+		case "url":
+			s.URL()
+		case "uuid":
+			s.UUID()
+		case "ip":
+			s.IP()
+		case "ipv4":
+			s.IPv4()
+		case "ipv6":
+			s.IPv6()
+		case "contains":
+			s.Contains(f.param(r))
+		case "startswith":
+			s.HasPrefix(f.param(r))
+		case "endswith":
+			s.HasSuffix(f.param(r))
+		default:
+			f.panicf("unknown rule %s for strings", r.name)
+		}
+	}
+	return s
+}
+
+func numberFromTag[T Numeric](s *NumberSchema[T], rules []tagRule, f tagField) *NumberSchema[T] {
+	for _, r := range rules {
+		switch r.name {
+		case "required":
+			s.Required()
+		case "omitempty":
+		case "default":
+			s.Default(parseTagNumber[T](f, r, f.param(r)))
+		case "min", "gte":
+			s.GTE(parseTagNumber[T](f, r, f.param(r)))
+		case "max", "lte":
+			s.LTE(parseTagNumber[T](f, r, f.param(r)))
+		case "gt":
+			s.GT(parseTagNumber[T](f, r, f.param(r)))
+		case "lt":
+			s.LT(parseTagNumber[T](f, r, f.param(r)))
+		case "eq":
+			s.EQ(parseTagNumber[T](f, r, f.param(r)))
+		case "oneof":
+			values := strings.Fields(f.param(r))
+			enum := make([]T, len(values))
+			for i, v := range values {
+				enum[i] = parseTagNumber[T](f, r, v)
+			}
+			s.OneOf(enum)
+		default:
+			f.panicf("unknown rule %s for numbers", r.name)
+		}
+	}
+	return s
+}
+
+func parseTagNumber[T Numeric](f tagField, r tagRule, param string) T {
+	var err error
+	var n T
+	switch reflect.TypeOf(n).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var x int64
+		x, err = strconv.ParseInt(param, 10, 64)
+		n = T(x)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var x uint64
+		x, err = strconv.ParseUint(param, 10, 64)
+		n = T(x)
+	default:
+		var x float64
+		x, err = strconv.ParseFloat(param, 64)
+		n = T(x)
+	}
+	if err != nil {
+		f.panicf("rule %s expects a %T param, got %q", r.name, n, param)
+	}
+	return n
+}
+
+func boolFromTag(s *BoolSchema[bool], rules []tagRule, f tagField) *BoolSchema[bool] {
+	for _, r := range rules {
+		switch r.name {
+		case "required":
+			s.Required()
+		case "omitempty":
+		case "default":
+			v, err := strconv.ParseBool(f.param(r))
+			if err != nil {
+				f.panicf("rule default expects a bool param, got %q", r.param)
+			}
+			s.Default(v)
+		default:
+			f.panicf("unknown rule %s for bools", r.name)
+		}
+	}
+	return s
+}
+
+func timeFromTag(s *TimeSchema, rules []tagRule, f tagField) *TimeSchema {
+	for _, r := range rules {
+		switch r.name {
+		case "required":
+			s.Required()
+		case "omitempty":
+		default:
+			f.panicf("unknown rule %s for time.Time", r.name)
+		}
+	}
+	return s
+}
@@ -0,0 +1,173 @@
+package zog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Oudwins/zog/zconst"
+	"github.com/stretchr/testify/assert"
+)
+
+type tagAddress struct {
+	City string `z:"required,min=2"`
+}
+
+type tagBase struct {
+	ID int `zog:"id" z:"required,gt=0"`
+}
+
+type tagUser struct {
+	tagBase
+	Email    string         `zog:"email" z:"required,email,max=255"`
+	Name     string         `z:"default=anon"`
+	Role     string         `z:"oneof=admin user"`
+	Age      int            `z:"gte=18,lte=130"`
+	Score    float64        `z:"lt=10.5"`
+	Active   bool           `z:"default=true"`
+	Born     time.Time      `z:"required"`
+	Tags     []string       `z:"max=2,dive,min=2"`
+	Meta     map[string]int `z:"dive,gte=0"`
+	Address  tagAddress
+	Nickname *string           `z:"required,min=3"`
+	Ignored  chan int          `z:"-"`
+	Extra    map[string]string `z:"-"`
+	private  string
+}
+
+func TestFromStructValid(t *testing.T) {
+	nick := "bob"
+	data := map[string]any{
+		"id":       1,
+		"email":    "bob@example.com",
+		"role":     "admin",
+		"age":      30,
+		"score":    1.5,
+		"born":     time.Now(),
+		"tags":     []string{"ab", "cd"},
+		"meta":     map[string]int{"a": 1},
+		"address":  map[string]any{"city": "Madrid"},
+		"nickname": nick,
+	}
+	var u tagUser
+	errs := FromStruct[tagUser]().Parse(data, &u)
+	assert.Nil(t, errs)
+	assert.Equal(t, 1, u.ID)
+	assert.Equal(t, "bob@example.com", u.Email)
+	assert.Equal(t, "anon", u.Name)
+	assert.True(t, u.Active)
+	assert.Equal(t, "Madrid", u.Address.City)
+	assert.Equal(t, &nick, u.Nickname)
+	assert.Empty(t, u.private)
+}
+
+func TestFromStructIssues(t *testing.T) {
+	u := tagUser{
+		Email:    "not an email",
+		Role:     "root",
+		Age:      10,
+		Score:    11,
+		Tags:     []string{"a", "bc", "de"},
+		Meta:     map[string]int{"a": -1},
+		Address:  tagAddress{City: "M"},
+		Nickname: nil,
+	}
+	errs := FromStruct[tagUser]().Validate(&u)
+
+	codes := map[string]zconst.ZogIssueCode{}
+	for _, issue := range errs {
+		codes[issue.PathString()] = issue.Code
+	}
+	assert.Equal(t, zconst.IssueCodeRequired, codes["id"])
+	assert.Equal(t, zconst.IssueCodeEmail, codes["email"])
+	assert.Equal(t, zconst.IssueCodeOneOf, codes["role"])
+	assert.Equal(t, zconst.IssueCodeGTE, codes["age"])
+	assert.Equal(t, zconst.IssueCodeLT, codes["score"])
+	assert.Equal(t, zconst.IssueCodeRequired, codes["born"])
+	assert.Equal(t, zconst.IssueCodeMax, codes["tags"])
+	assert.Equal(t, zconst.IssueCodeMin, codes["tags[0]"])
+	assert.Equal(t, zconst.IssueCodeGTE, codes["meta[a]"])
+	assert.Equal(t, zconst.IssueCodeMin, codes["address.city"])
+	assert.Equal(t, zconst.IssueCodeNotNil, codes["nickname"])
+}
+
+func TestFromStructShape(t *testing.T) {
+	desc := FromStruct[tagUser]().Describe()
+	assert.Contains(t, desc.Shape, "iD")
+	assert.Contains(t, desc.Shape, "email")
+	assert.NotContains(t, desc.Shape, "ignored")
+	assert.NotContains(t, desc.Shape, "extra")
+	assert.NotContains(t, desc.Shape, "private")
+	assert.NotContains(t, desc.Shape, "tagBase")
+	assert.True(t, desc.Shape["email"].Required)
+	assert.Equal(t, zconst.ZogIssueCode(zconst.IssueCodeEmail), desc.Shape["email"].Tests[0].IssueCode)
+	assert.Equal(t, map[string]any{"max": 255}, desc.Shape["email"].Tests[1].Params)
+}
+
+func TestFromStructCachesAndClones(t *testing.T) {
+	a := FromStruct[tagAddress]()
+	b := FromStruct[tagAddress]()
+	assert.NotSame(t, a, b)
+
+	a.Extend(Shape{"zip": String().Required()})
+	a.Required()
+	assert.NotContains(t, b.Describe().Shape, "zip")
+	assert.False(t, FromStruct[tagAddress]().Describe().Required)
+}
+
+type tagNode struct {
+	Value    int        `z:"required"`
+	Children []*tagNode `z:"max=2"`
+	Parent   *tagNode
+}
+
+func TestFromStructRecursive(t *testing.T) {
+	s := FromStruct[tagNode]()
+	var n tagNode
+	errs := s.Parse(map[string]any{
+		"value": 1,
+		"children": []any{
+			map[string]any{"value": 2},
+			map[string]any{"children": []any{}},
+		},
+	}, &n)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "children[1].value", errs[0].PathString())
+	assert.Equal(t, 2, n.Children[0].Value)
+}
+
+func TestFromStructPanics(t *testing.T) {
+	assert.Panics(t, func() {
+		FromStruct[string]()
+	})
+	assert.Panics(t, func() {
+		type s struct {
+			Name string `z:"nope"`
+		}
+		FromStruct[s]()
+	})
+	assert.Panics(t, func() {
+		type s struct {
+			Age int `z:"gte=abc"`
+		}
+		FromStruct[s]()
+	})
+	assert.Panics(t, func() {
+		type s struct {
+			Name string `z:"max"`
+		}
+		FromStruct[s]()
+	})
+	assert.Panics(t, func() {
+		type s struct {
+			Address tagAddress `z:"required"`
+		}
+		FromStruct[s]()
+	})
+	assert.Panics(t, func() {
+		type myString string
+		type s struct {
+			Name myString
+		}
+		FromStruct[s]()
+	})
+}
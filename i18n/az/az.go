@@ -77,12 +77,60 @@ var Map zconst.LangMap = map[zconst.ZogType]map[zconst.ZogIssueCode]string{
 		zconst.NotIssueCode(zconst.IssueCodeLen):      "siyahıda {{len}} element olmamalıdır",
 		zconst.IssueCodeContains:                      "siyahı daxilində '{{contained}}' olmalıdır",
 		zconst.NotIssueCode(zconst.IssueCodeContains): "siyahı daxilində '{{contained}}' olmamalıdır",
+		zconst.IssueCodeCancelled:                     "yoxlama ləğv edildi",
 		zconst.IssueCodeFallback:                      "siyahı yanlışdır",
 	},
-	zconst.TypeStruct: {
+	zconst.TypeTuple: {
+		zconst.IssueCodeRequired:  "tələb olunur",
+		zconst.IssueCodeMin:       "kortejdə ən azı {{min}} element olmalıdır",
+		zconst.IssueCodeLen:       "kortejdə {{len}} element olmalıdır",
+		zconst.IssueCodeCancelled: "yoxlama ləğv edildi",
+		zconst.IssueCodeFallback:  "kortej yanlışdır",
+	},
+	zconst.TypeLiteral: {
 		zconst.IssueCodeRequired: "tələb olunur",
-		zconst.IssueCodeNotNil:   "boş olmamalıdır",
-		zconst.IssueCodeFallback: "struktur yanlışdır",
+		zconst.IssueCodeLiteral:  "qiymət {{literal}} olmalıdır",
+		zconst.IssueCodeFallback: "qiymət yanlışdır",
+	},
+	zconst.TypeEnum: {
+		zconst.IssueCodeRequired: "tələb olunur",
+		zconst.IssueCodeOneOf:    "qiymət {{one_of_options}} variantlarından biri olmalıdır",
+		zconst.IssueCodeFallback: "qiymət yanlışdır",
+	},
+	zconst.TypeFile: {
+		zconst.IssueCodeRequired:  "tələb olunur",
+		zconst.IssueCodeMin:       "fayl ən azı {{min}} bayt olmalıdır",
+		zconst.IssueCodeMax:       "fayl ən çoxu {{max}} bayt olmalıdır",
+		zconst.IssueCodeMimeType:  "fayl növü {{mime_type}} variantlarından biri olmalıdır",
+		zconst.IssueCodeExtension: "fayl uzantısı {{extension}} variantlarından biri olmalıdır",
+		zconst.IssueCodeFallback:  "fayl yanlışdır",
+	},
+	zconst.TypeExecution: {
+		zconst.IssueCodeMaxIssues: "həddən çox problem, icra {{max_issues}} problemdən sonra dayandırıldı",
+		zconst.IssueCodeMaxDepth:  "maksimum iç-içəlik dərinliyi {{max_depth}} aşılıb",
+		zconst.IssueCodeFallback:  "icra uğursuz oldu",
+	},
+	zconst.TypeMap: {
+		zconst.IssueCodeRequired:  "tələb olunur",
+		zconst.IssueCodeNotNil:    "boş olmamalıdır",
+		zconst.IssueCodeMin:       "xəritədə ən azı {{min}} element olmalıdır",
+		zconst.IssueCodeMax:       "xəritədə maksimum {{max}} element olmalıdır",
+		zconst.IssueCodeLen:       "xəritədə {{len}} element olmalıdır",
+		zconst.IssueCodeCancelled: "yoxlama ləğv edildi",
+		zconst.IssueCodeFallback:  "xəritə yanlışdır",
+	},
+	zconst.TypeUnion: {
+		zconst.IssueCodeRequired:     "tələb olunur",
+		zconst.IssueCodeInvalidUnion: "dəyər icazə verilən növlərdən heç birinə uyğun gəlmir",
+		zconst.IssueCodeFallback:     "dəyər yanlışdır",
+	},
+	zconst.TypeStruct: {
+		zconst.IssueCodeRequired:             "tələb olunur",
+		zconst.IssueCodeNotNil:               "boş olmamalıdır",
+		zconst.IssueCodeCancelled:            "yoxlama ləğv edildi",
+		zconst.IssueCodeFallback:             "struktur yanlışdır",
+		zconst.IssueCodeInvalidDiscriminator: "{{invalid_discriminator}} variantlarından biri olmalıdır",
+		zconst.IssueCodeUnrecognizedKeys:     "tanınmayan açarlar {{unrecognized_keys}}",
 		// JSON
 		zconst.IssueCodeInvalidJSON: "JSON formatı yanlışdır",
 		// ZHTTP ISSUES
@@ -77,12 +77,60 @@ var Map zconst.LangMap = map[zconst.ZogType]map[zconst.ZogIssueCode]string{
 		zconst.NotIssueCode(zconst.IssueCodeLen):      "slice must not contain exactly {{len}} items",
 		zconst.IssueCodeContains:                      "slice must contain {{contained}}",
 		zconst.NotIssueCode(zconst.IssueCodeContains): "slice must not contain {{contained}}",
+		zconst.IssueCodeCancelled:                     "validation was cancelled",
 		zconst.IssueCodeFallback:                      "slice is invalid",
 	},
-	zconst.TypeStruct: {
+	zconst.TypeTuple: {
+		zconst.IssueCodeRequired:  "is required",
+		zconst.IssueCodeMin:       "tuple must contain at least {{min}} items",
+		zconst.IssueCodeLen:       "tuple must contain exactly {{len}} items",
+		zconst.IssueCodeCancelled: "validation was cancelled",
+		zconst.IssueCodeFallback:  "tuple is invalid",
+	},
+	zconst.TypeLiteral: {
 		zconst.IssueCodeRequired: "is required",
-		zconst.IssueCodeNotNil:   "must not be empty",
-		zconst.IssueCodeFallback: "struct is invalid",
+		zconst.IssueCodeLiteral:  "must be {{literal}}",
+		zconst.IssueCodeFallback: "value is invalid",
+	},
+	zconst.TypeEnum: {
+		zconst.IssueCodeRequired: "is required",
+		zconst.IssueCodeOneOf:    "must be one of {{one_of_options}}",
+		zconst.IssueCodeFallback: "value is invalid",
+	},
+	zconst.TypeFile: {
+		zconst.IssueCodeRequired:  "is required",
+		zconst.IssueCodeMin:       "file must be at least {{min}} bytes",
+		zconst.IssueCodeMax:       "file must be at most {{max}} bytes",
+		zconst.IssueCodeMimeType:  "file type must be one of {{mime_type}}",
+		zconst.IssueCodeExtension: "file extension must be one of {{extension}}",
+		zconst.IssueCodeFallback:  "file is invalid",
+	},
+	zconst.TypeExecution: {
+		zconst.IssueCodeMaxIssues: "too many issues, execution stopped after {{max_issues}}",
+		zconst.IssueCodeMaxDepth:  "maximum nesting depth of {{max_depth}} exceeded",
+		zconst.IssueCodeFallback:  "execution failed",
+	},
+	zconst.TypeMap: {
+		zconst.IssueCodeRequired:  "is required",
+		zconst.IssueCodeNotNil:    "must not be empty",
+		zconst.IssueCodeMin:       "map must contain at least {{min}} entries",
+		zconst.IssueCodeMax:       "map must contain at most {{max}} entries",
+		zconst.IssueCodeLen:       "map must contain exactly {{len}} entries",
+		zconst.IssueCodeCancelled: "validation was cancelled",
+		zconst.IssueCodeFallback:  "map is invalid",
+	},
+	zconst.TypeUnion: {
+		zconst.IssueCodeRequired:     "is required",
+		zconst.IssueCodeInvalidUnion: "value does not match any of the allowed types",
+		zconst.IssueCodeFallback:     "value is invalid",
+	},
+	zconst.TypeStruct: {
+		zconst.IssueCodeRequired:             "is required",
+		zconst.IssueCodeNotNil:               "must not be empty",
+		zconst.IssueCodeCancelled:            "validation was cancelled",
+		zconst.IssueCodeFallback:             "struct is invalid",
+		zconst.IssueCodeInvalidDiscriminator: "must be one of {{invalid_discriminator}}",
+		zconst.IssueCodeUnrecognizedKeys:     "unrecognized keys {{unrecognized_keys}}",
 		// JSON
 		zconst.IssueCodeInvalidJSON: "invalid json body",
 		// ZHTTP ISSUES
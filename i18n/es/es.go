@@ -76,12 +76,60 @@ var Map zconst.LangMap = map[zconst.ZogType]map[zconst.ZogIssueCode]string{
 		zconst.NotIssueCode(zconst.IssueCodeLen):      "Lista no debe contener exactamente {{len}} elementos",
 		zconst.IssueCodeContains:                      "Lista debe contener {{contained}}",
 		zconst.NotIssueCode(zconst.IssueCodeContains): "Lista no debe contener {{contained}}",
+		zconst.IssueCodeCancelled:                     "La validación fue cancelada",
 		zconst.IssueCodeFallback:                      "Lista no es válida",
 	},
-	zconst.TypeStruct: {
+	zconst.TypeTuple: {
+		zconst.IssueCodeRequired:  "Es obligatorio",
+		zconst.IssueCodeMin:       "Tupla debe contener al menos {{min}} elementos",
+		zconst.IssueCodeLen:       "Tupla debe contener exactamente {{len}} elementos",
+		zconst.IssueCodeCancelled: "La validación fue cancelada",
+		zconst.IssueCodeFallback:  "Tupla no es válida",
+	},
+	zconst.TypeLiteral: {
 		zconst.IssueCodeRequired: "Es obligatorio",
-		zconst.IssueCodeNotNil:   "No debe estar vacio",
-		zconst.IssueCodeFallback: "Estructura no es válida",
+		zconst.IssueCodeLiteral:  "Debe ser {{literal}}",
+		zconst.IssueCodeFallback: "Valor no es válido",
+	},
+	zconst.TypeEnum: {
+		zconst.IssueCodeRequired: "Es obligatorio",
+		zconst.IssueCodeOneOf:    "Debe ser uno de los siguientes: {{one_of_options}}",
+		zconst.IssueCodeFallback: "Valor no es válido",
+	},
+	zconst.TypeFile: {
+		zconst.IssueCodeRequired:  "Es obligatorio",
+		zconst.IssueCodeMin:       "Archivo debe tener al menos {{min}} bytes",
+		zconst.IssueCodeMax:       "Archivo debe tener como máximo {{max}} bytes",
+		zconst.IssueCodeMimeType:  "Tipo de archivo debe ser uno de los siguientes: {{mime_type}}",
+		zconst.IssueCodeExtension: "Extensión de archivo debe ser una de las siguientes: {{extension}}",
+		zconst.IssueCodeFallback:  "Archivo no es válido",
+	},
+	zconst.TypeExecution: {
+		zconst.IssueCodeMaxIssues: "Demasiados problemas, la ejecución se detuvo después de {{max_issues}}",
+		zconst.IssueCodeMaxDepth:  "Se superó la profundidad máxima de anidación de {{max_depth}}",
+		zconst.IssueCodeFallback:  "La ejecución falló",
+	},
+	zconst.TypeMap: {
+		zconst.IssueCodeRequired:  "Es obligatorio",
+		zconst.IssueCodeNotNil:    "No debe estar vacio",
+		zconst.IssueCodeMin:       "Mapa debe contener al menos {{min}} entradas",
+		zconst.IssueCodeMax:       "Mapa debe contener como máximo {{max}} entradas",
+		zconst.IssueCodeLen:       "Mapa debe contener exactamente {{len}} entradas",
+		zconst.IssueCodeCancelled: "La validación fue cancelada",
+		zconst.IssueCodeFallback:  "Mapa no es válido",
+	},
+	zconst.TypeUnion: {
+		zconst.IssueCodeRequired:     "Es obligatorio",
+		zconst.IssueCodeInvalidUnion: "Valor no coincide con ninguno de los tipos permitidos",
+		zconst.IssueCodeFallback:     "Valor no es válido",
+	},
+	zconst.TypeStruct: {
+		zconst.IssueCodeRequired:             "Es obligatorio",
+		zconst.IssueCodeNotNil:               "No debe estar vacio",
+		zconst.IssueCodeCancelled:            "La validación fue cancelada",
+		zconst.IssueCodeFallback:             "Estructura no es válida",
+		zconst.IssueCodeInvalidDiscriminator: "Debe ser uno de {{invalid_discriminator}}",
+		zconst.IssueCodeUnrecognizedKeys:     "Claves no reconocidas {{unrecognized_keys}}",
 		// JSON
 		zconst.IssueCodeInvalidJSON: "JSON no válido",
 		// ZHTTP ISSUES
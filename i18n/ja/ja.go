@@ -77,12 +77,60 @@ var Map zconst.LangMap = map[zconst.ZogType]map[zconst.ZogIssueCode]string{
 		zconst.NotIssueCode(zconst.IssueCodeLen):      "要素数がちょうど {{len}} ではいけません",
 		zconst.IssueCodeContains:                      "{{contained}} を含める必要があります",
 		zconst.NotIssueCode(zconst.IssueCodeContains): "{{contained}} を含んではいけません",
+		zconst.IssueCodeCancelled:                     "検証がキャンセルされました",
 		zconst.IssueCodeFallback:                      "スライスが無効です",
 	},
-	zconst.TypeStruct: {
+	zconst.TypeTuple: {
+		zconst.IssueCodeRequired:  "必須です",
+		zconst.IssueCodeMin:       "要素数は {{min}} 以上である必要があります",
+		zconst.IssueCodeLen:       "要素数はちょうど {{len}} である必要があります",
+		zconst.IssueCodeCancelled: "検証がキャンセルされました",
+		zconst.IssueCodeFallback:  "タプルが無効です",
+	},
+	zconst.TypeLiteral: {
 		zconst.IssueCodeRequired: "必須です",
-		zconst.IssueCodeNotNil:   "空ではいけません",
-		zconst.IssueCodeFallback: "構造体が無効です",
+		zconst.IssueCodeLiteral:  "{{literal}} である必要があります",
+		zconst.IssueCodeFallback: "値が無効です",
+	},
+	zconst.TypeEnum: {
+		zconst.IssueCodeRequired: "必須です",
+		zconst.IssueCodeOneOf:    "{{one_of_options}} のいずれかである必要があります",
+		zconst.IssueCodeFallback: "値が無効です",
+	},
+	zconst.TypeFile: {
+		zconst.IssueCodeRequired:  "必須です",
+		zconst.IssueCodeMin:       "ファイルサイズは {{min}} バイト以上である必要があります",
+		zconst.IssueCodeMax:       "ファイルサイズは {{max}} バイト以下である必要があります",
+		zconst.IssueCodeMimeType:  "ファイルの種類は {{mime_type}} のいずれかである必要があります",
+		zconst.IssueCodeExtension: "ファイルの拡張子は {{extension}} のいずれかである必要があります",
+		zconst.IssueCodeFallback:  "ファイルが無効です",
+	},
+	zconst.TypeExecution: {
+		zconst.IssueCodeMaxIssues: "問題が多すぎるため {{max_issues}} 件で実行を停止しました",
+		zconst.IssueCodeMaxDepth:  "最大ネスト深度 {{max_depth}} を超えています",
+		zconst.IssueCodeFallback:  "実行に失敗しました",
+	},
+	zconst.TypeMap: {
+		zconst.IssueCodeRequired:  "必須です",
+		zconst.IssueCodeNotNil:    "空ではいけません",
+		zconst.IssueCodeMin:       "エントリ数は {{min}} 以上である必要があります",
+		zconst.IssueCodeMax:       "エントリ数は {{max}} 以下である必要があります",
+		zconst.IssueCodeLen:       "エントリ数はちょうど {{len}} である必要があります",
+		zconst.IssueCodeCancelled: "検証がキャンセルされました",
+		zconst.IssueCodeFallback:  "マップが無効です",
+	},
+	zconst.TypeUnion: {
+		zconst.IssueCodeRequired:     "必須です",
+		zconst.IssueCodeInvalidUnion: "許可されたいずれの型にも一致しません",
+		zconst.IssueCodeFallback:     "値が無効です",
+	},
+	zconst.TypeStruct: {
+		zconst.IssueCodeRequired:             "必須です",
+		zconst.IssueCodeNotNil:               "空ではいけません",
+		zconst.IssueCodeCancelled:            "検証がキャンセルされました",
+		zconst.IssueCodeFallback:             "構造体が無効です",
+		zconst.IssueCodeInvalidDiscriminator: "{{invalid_discriminator}} のいずれかである必要があります",
+		zconst.IssueCodeUnrecognizedKeys:     "認識されないキー {{unrecognized_keys}}",
 		// JSON
 		zconst.IssueCodeInvalidJSON: "無効なJSONボディです",
 		// ZHTTP ISSUES
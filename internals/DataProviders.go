@@ -31,9 +31,21 @@ type DataProvider interface {
 	GetUnderlying() any // returns the underlying value the dp is wrapping
 }
 
+// Optional interface for data providers that can list the keys in the input data. Used to find unknown keys (i.e StructSchema.Strict() & Passthrough())
+type KeysDataProvider interface {
+	Keys() []string
+}
+
+// Optional interface for data providers that read each field from one of several sources (i.e zhttp.All). The source is pushed as its own path segment before the key returned by GetByField
+type SourceDataProvider interface {
+	FieldSource(field reflect.StructField) string
+}
+
 // checks that we implement the interface
 var _ DataProvider = &MapDataProvider[string]{}
+var _ KeysDataProvider = &MapDataProvider[string]{}
 var _ DataProvider = &StructDataProvider{}
+var _ DataProvider = &ValueDataProvider{}
 
 type StructDataProvider struct {
 	value reflect.Value
@@ -94,6 +106,14 @@ func (m *MapDataProvider[T]) GetUnderlying() any {
 	return m.M
 }
 
+func (m *MapDataProvider[T]) Keys() []string {
+	keys := make([]string, 0, len(m.M))
+	for k := range m.M {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
 func NewMapDataProvider[T any](m map[string]T, tag *string) DataProvider {
 	if len(m) == 0 {
 		return &EmptyDataProvider{}
@@ -131,7 +151,36 @@ func (e *EmptyDataProvider) GetUnderlying() any {
 	return e.Underlying
 }
 
+func (e *EmptyDataProvider) Keys() []string {
+	return nil
+}
+
+// Wraps decoded values that are not objects (i.e json arrays or primitives) so they can be returned by a DpFactory. Fields can't be read from it, schemas that don't parse into structs use the underlying value
+type ValueDataProvider struct {
+	Value any
+}
+
+func (v *ValueDataProvider) Get(key string) any {
+	return nil
+}
+
+func (v *ValueDataProvider) GetByField(field reflect.StructField, fallback string) (any, string) {
+	return nil, fallback
+}
+
+func (v *ValueDataProvider) GetNestedProvider(key string) DataProvider {
+	return nil
+}
+
+func (v *ValueDataProvider) GetUnderlying() any {
+	return v.Value
+}
+
 func TryNewAnyDataProvider(val any) (DataProvider, error) {
+	// the wrapped value is not an object so it is converted like any other value
+	if v, ok := val.(*ValueDataProvider); ok {
+		return TryNewAnyDataProvider(v.Value)
+	}
 	dp, ok := val.(DataProvider)
 	if ok {
 		return dp, nil
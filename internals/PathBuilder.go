@@ -38,6 +38,13 @@ func (p *PathBuilder) Pop() {
 	*p = (*p)[:len(*p)-1]
 }
 
+// Returns a new path builder with the same path. Used to give each worker its own path
+func (p *PathBuilder) Clone() *PathBuilder {
+	pb := NewPathBuilder()
+	*pb = append(*pb, *p...)
+	return pb
+}
+
 func (p *PathBuilder) ToListClone() []string {
 	if len(*p) == 0 {
 		return nil
@@ -1,7 +1,10 @@
 package internals
 
 import (
+	"context"
 	"fmt"
+	"reflect"
+	"sync"
 
 	zconst "github.com/Oudwins/zog/zconst"
 )
@@ -13,6 +16,8 @@ type Ctx interface {
 	*/
 	// Get a value from the context
 	Get(key string) any
+	// Returns the context.Context passed with z.WithContext or context.Background() if none was passed
+	Context() context.Context
 	// Adds an issue to the schema execution.
 	AddIssue(e *ZogIssue)
 
@@ -47,13 +52,70 @@ func NewExecCtx(errs ZogIssues, fmter IssueFmtFunc) *ExecCtx {
 	c := ExecCtxPool.Get().(*ExecCtx)
 	c.Fmter = fmter
 	c.Errors = errs
+	c.LazyDepth = 0
+	c.ctx = nil
+	c.cancelled = false
+	c.parent = nil
+	c.workers = nil
+	c.limit = nil
+	c.committed = true
 	return c
 }
 
+// Limits the number of issues reported by an execution. Shared by every context of the execution
+type issueLimit struct {
+	mu  sync.Mutex
+	max int
+	// whether to add a max_issues issue when an issue is dropped
+	marker  bool
+	count   int
+	stopped bool
+}
+
+// records an issue. Returns whether the issue should be kept & whether it is the first dropped issue
+func (l *issueLimit) add() (keep bool, truncated bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.stopped {
+		return false, false
+	}
+	if l.count == l.max {
+		l.stopped = true
+		return false, true
+	}
+	l.count++
+	// without a marker there is no need to wait for the next issue to know the list was truncated
+	if l.count == l.max && !l.marker {
+		l.stopped = true
+	}
+	return true, false
+}
+
+func (l *issueLimit) isStopped() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.stopped
+}
+
 type ExecCtx struct {
 	Fmter  IssueFmtFunc
 	Errors ZogIssues
-	m      map[string]any
+	// Number of lazy schemas currently being executed. Used to stop infinite recursion
+	LazyDepth int
+	m         map[string]any
+	ctx       context.Context
+	// set once the cancelled issue has been added so it is only reported once
+	cancelled bool
+	// context this one was forked from. Cancellation is always reported to the root context since forked issues may be discarded (i.e catch & unions)
+	parent *ExecCtx
+	// guards cancelled & adding the cancelled issue since workers may report it concurrently
+	mu sync.Mutex
+	// tokens for the extra goroutines the execution may use. nil if the execution is sequential. Shared by all forks
+	workers chan struct{}
+	// max issues for the execution. nil if there is no limit. Shared by all forks
+	limit *issueLimit
+	// whether issues added to this context end up in the execution result. False for forks whose issues may be discarded (i.e catch & unions). Only these issues count towards the limit
+	committed bool
 }
 
 func (c *ExecCtx) HasErrored() bool {
@@ -75,8 +137,73 @@ func (c *ExecCtx) Get(key string) any {
 	return c.m[key]
 }
 
+func (c *ExecCtx) SetContext(ctx context.Context) {
+	c.ctx = ctx
+}
+
+// Sets the max number of goroutines used by the execution. n <= 1 means the execution is sequential
+func (c *ExecCtx) SetParallelism(n int) {
+	if n <= 1 {
+		c.workers = nil
+		return
+	}
+	// the goroutine running the execution counts as one of them
+	c.workers = make(chan struct{}, n-1)
+}
+
+// Returns the max number of goroutines used by the execution
+func (c *ExecCtx) Parallelism() int {
+	return cap(c.workers) + 1
+}
+
+// Reserves a goroutine for the execution. Returns false if they are all in use, in which case the work should run in the current goroutine
+func (c *ExecCtx) TryAcquireWorker() bool {
+	select {
+	case c.workers <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// Releases a goroutine reserved with TryAcquireWorker
+func (c *ExecCtx) ReleaseWorker() {
+	<-c.workers
+}
+
+// Stops the execution once it has n issues. If marker is true the execution keeps going until another issue is found, which is replaced by a max_issues issue to signal the list was truncated. n <= 0 means no limit
+func (c *ExecCtx) SetMaxIssues(n int, marker bool) {
+	if n <= 0 {
+		c.limit = nil
+		return
+	}
+	c.limit = &issueLimit{max: n, marker: marker}
+}
+
+func (c *ExecCtx) Context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
+}
+
 // Adds a ZogIssue to the execution context.
 func (c *ExecCtx) AddIssue(e *ZogIssue) {
+	if c.limit != nil && c.committed {
+		keep, truncated := c.limit.add()
+		if !keep {
+			if truncated {
+				// the marker is about the execution, not the dropped issue
+				marker := NewZogIssue().SetCode(zconst.IssueCodeMaxIssues).SetDType(zconst.TypeExecution).SetParams(map[string]any{
+					zconst.IssueCodeMaxIssues: c.limit.max,
+				})
+				c.Fmter(marker, c)
+				c.Errors.Add(marker)
+			}
+			FreeIssue(e)
+			return
+		}
+	}
 	if e.Message == "" {
 		c.Fmter(e, c)
 	}
@@ -101,6 +228,29 @@ func (c *ExecCtx) FmtErr(e *ZogIssue) {
 	c.Fmter(e, c)
 }
 
+// Returns a new execution context that shares this context's formatter & values but collects issues into errs. Used by schemas that need to run sub schemas speculatively (i.e unions)
+func (c *ExecCtx) Fork(errs ZogIssues) *ExecCtx {
+	c2 := ExecCtxPool.Get().(*ExecCtx)
+	c2.Fmter = c.Fmter
+	c2.Errors = errs
+	c2.LazyDepth = c.LazyDepth
+	c2.m = c.m
+	c2.ctx = c.ctx
+	c2.cancelled = false
+	c2.parent = c
+	c2.workers = c.workers
+	c2.limit = c.limit
+	c2.committed = false
+	return c2
+}
+
+// Same as Fork but for forks whose issues are always added back to this context (i.e concurrent workers). They count towards the max issues of the execution
+func (c *ExecCtx) ForkWorker(errs ZogIssues) *ExecCtx {
+	c2 := c.Fork(errs)
+	c2.committed = c.committed
+	return c2
+}
+
 func (c *ExecCtx) NewSchemaCtx(val any, destPtr any, path *PathBuilder, dtype zconst.ZogType) *SchemaCtx {
 	c2 := SchemaCtxPool.Get().(*SchemaCtx)
 	c2.ExecCtx = c
@@ -111,6 +261,7 @@ func (c *ExecCtx) NewSchemaCtx(val any, destPtr any, path *PathBuilder, dtype zc
 	c2.CanCatch = false
 	c2.HasCaught = false
 	c2.Exit = false
+	c2.Parent = reflect.Value{}
 	return c2
 }
 
@@ -124,6 +275,7 @@ func (c *ExecCtx) NewValidateSchemaCtx(valPtr any, path *PathBuilder, dtype zcon
 	c2.CanCatch = false
 	c2.HasCaught = false
 	c2.Exit = false
+	c2.Parent = reflect.Value{}
 	return c2
 }
 
@@ -141,6 +293,8 @@ type SchemaCtx struct {
 	Exit      bool
 	HasCaught bool
 	Processor any
+	// Struct the value is a field of. Only set for the direct children of a struct schema. Used by z.When to read sibling fields
+	Parent reflect.Value
 }
 
 func (c *SchemaCtx) AddIssue(e *ZogIssue) {
@@ -205,6 +359,38 @@ func (c *SchemaCtx) IssueFromUnknownError(err error) *ZogIssue {
 	return zerr
 }
 
+// Please don't depend on this method it may change
+// Returns true if the context.Context of the execution is done. The first time it adds a cancelled issue at the current path. Catch doesn't apply to it since the value was never fully processed
+func (c *SchemaCtx) Cancelled() bool {
+	root := c.ExecCtx
+	for root.parent != nil {
+		root = root.parent
+	}
+	if c.ctx == nil {
+		return false
+	}
+	root.mu.Lock()
+	defer root.mu.Unlock()
+	if root.cancelled {
+		return true
+	}
+	if c.ctx.Err() == nil {
+		return false
+	}
+	root.cancelled = true
+	root.AddIssue(c.Issue().SetCode(zconst.IssueCodeCancelled).SetError(c.ctx.Err()))
+	return true
+}
+
+// Please don't depend on this method it may change
+// Returns true if the execution should stop. Either because it reached its max issues or because its context.Context is done (see Cancelled)
+func (c *SchemaCtx) Stopped() bool {
+	if c.limit != nil && c.limit.isStopped() {
+		return true
+	}
+	return c.Cancelled()
+}
+
 // Frees the context to be reused
 func (c *SchemaCtx) Free() {
 	SchemaCtxPool.Put(c)
@@ -6,6 +6,13 @@ const (
 	PanicTypeCast                        = "Zog Panic: Type Cast Error\n Current context: %s\n Expected valPtr type to correspond with type defined in schema. But it does not. Expected type: *%T, got: %T\nFor more information see: https://zog.dev/panics#type-cast-errors"
 	PanicTypeCastCoercer                 = "Zog Panic: Type Cast Error\n Current context: %s\n Expected coercer return value to correspond with type defined in schema. But it does not. Expected type: *%T, got: %T\nFor more information see: https://zog.dev/panics#type-cast-errors"
 	PanicMissingStructField              = "Zog Panic: Struct Schema Definition Error\n Current context: %s\n Provided struct is missing expected schema key: %s.\n This means you have made a mistake in your schema definition.\nFor more information see: https://zog.dev/panics#schema-definition-errors"
+	PanicMissingDiscriminatorConstructor = "Zog Panic: Discriminated Union Definition Error\n Current context: %s\n Missing constructor for variant: %s. Parsing into an interface requires every variant to register a constructor that returns a non nil pointer.\nFor more information see: https://zog.dev/panics#schema-definition-errors"
+	PanicInvalidPassthroughField         = "Zog Panic: Struct Schema Definition Error\n Current context: %s\n Passthrough field %s must exist in the destination struct and be of type map[string]any.\nFor more information see: https://zog.dev/panics#schema-definition-errors"
+	PanicPassthroughDefinition           = "Zog Panic: Struct Schema Definition Error\n Passthrough field: %s\n %s\nFor more information see: https://zog.dev/panics#schema-definition-errors"
+	PanicStructTagDefinition             = "Zog Panic: Struct Tag Definition Error\n Type: %s, field: %s\n %s\nFor more information see: https://zog.dev/panics#schema-definition-errors"
+	PanicWhenDefinition                  = "Zog Panic: When Definition Error\n Sibling field: %s\n %s\nFor more information see: https://zog.dev/panics#schema-definition-errors"
+	PanicTupleDestination                = "Zog Panic: Tuple Destination Error\n Current context: %s\n %s\nFor more information see: https://zog.dev/panics#schema-definition-errors"
+	PanicEnumDefinition                  = "Zog Panic: Enum Definition Error\n Enum type: %s\n %s\nFor more information see: https://zog.dev/panics#schema-definition-errors"
 	PanicInvalidArgumentsExpectedPointer = "Zog Panic: Expected destination value to be a pointer but it was not. This is generally caused by forgetting to pass a pointer to your Validate/Parse function. Do schema.Validate(&myStruct), not schema.Validate(myStruct) "
 )
 
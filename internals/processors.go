@@ -17,3 +17,23 @@ func (p *TransformProcessor[T]) ZProcess(valPtr T, ctx Ctx) {
 		s.Exit = true
 	}
 }
+
+// Returns a copy of the processors slice. Tests & transforms are copied so that the new slice doesn't share any processor with the original
+func CloneProcessors[T any](processors []ZProcessor[T]) []ZProcessor[T] {
+	if processors == nil {
+		return nil
+	}
+	c := make([]ZProcessor[T], len(processors))
+	for i, processor := range processors {
+		switch x := processor.(type) {
+		case *Test[T]:
+			c[i] = x.Clone()
+		case *TransformProcessor[T]:
+			t := *x
+			c[i] = &t
+		default:
+			c[i] = processor
+		}
+	}
+	return c
+}
@@ -1,7 +1,9 @@
 package internals
 
 import (
+	"maps"
 	"reflect"
+	"slices"
 
 	zconst "github.com/Oudwins/zog/zconst"
 	"golang.org/x/exp/constraints"
@@ -74,6 +76,17 @@ type Test[T any] struct {
 	Func TFunc[T]
 }
 
+// Returns a copy of the test. Params & IssuePath are copied so that changing the copy doesn't affect the original. Safe to call on a nil test
+func (t *Test[T]) Clone() *Test[T] {
+	if t == nil {
+		return nil
+	}
+	c := *t
+	c.IssuePath = slices.Clone(t.IssuePath)
+	c.Params = maps.Clone(t.Params)
+	return &c
+}
+
 func (t *Test[T]) ZProcess(valPtr T, ctx Ctx) {
 	t.Func(valPtr, ctx)
 }
@@ -194,6 +207,19 @@ func EQ[T comparable](n T) (Test[*T], BoolTFunc[*T]) {
 	return t, fn
 }
 
+func Literal[T comparable](value T) (Test[*T], BoolTFunc[*T]) {
+	fn := func(val *T, ctx Ctx) bool {
+		return *val == value
+	}
+
+	t := Test[*T]{
+		IssueCode: zconst.IssueCodeLiteral,
+		Params:    make(map[string]any, 1),
+	}
+	t.Params[zconst.IssueCodeLiteral] = value
+	return t, fn
+}
+
 func LTE[T constraints.Ordered](n T) (Test[*T], BoolTFunc[*T]) {
 	fn := func(val *T, ctx Ctx) bool {
 		return *val <= n
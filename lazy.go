@@ -0,0 +1,154 @@
+package zog
+
+import (
+	"sync"
+
+	"github.com/Oudwins/zog/conf"
+	p "github.com/Oudwins/zog/internals"
+	"github.com/Oudwins/zog/zconst"
+)
+
+// Default max depth for lazy schemas. See LazySchema.MaxDepth
+const DefaultLazyMaxDepth = 100
+
+var _ ComplexZogSchema = &LazySchema{}
+
+type LazySchema struct {
+	fn       func() ZogSchema
+	once     sync.Once
+	schema   ZogSchema
+	maxDepth int
+}
+
+// Returns the type of the resolved schema
+func (v *LazySchema) getType() zconst.ZogType {
+	return v.resolve().getType()
+}
+
+// Sets the coercer for the resolved schema
+func (v *LazySchema) setCoercer(c CoercerFunc) {
+	v.resolve().setCoercer(c)
+}
+
+// Returns a deep copy of the schema. Used to clone inner schemas of complex schemas
+func (v *LazySchema) cloneSchema() ZogSchema {
+	return v.Clone()
+}
+
+// Returns the description of the schema. See z.Describe
+func (v *LazySchema) describe(d *describer) *SchemaDescription {
+	return d.schema(v.resolve())
+}
+
+// ! USER FACING FUNCTIONS
+
+// Creates a lazy schema. The function is called the first time the schema is executed and the result is cached.
+// This allows you to define recursive schemas. Usage:
+//
+//	var commentSchema *z.StructSchema
+//	commentSchema = z.Struct(z.Shape{
+//		"text":    z.String().Required(),
+//		"replies": z.Slice(z.Lazy(func() z.ZogSchema { return commentSchema })),
+//	})
+func Lazy(fn func() ZogSchema) *LazySchema {
+	return &LazySchema{
+		fn:       fn,
+		maxDepth: DefaultLazyMaxDepth,
+	}
+}
+
+// Sets the max number of nested lazy schemas allowed in a single execution. If it is exceeded a max_depth issue is added instead of going deeper.
+func (v *LazySchema) MaxDepth(n int) *LazySchema {
+	v.maxDepth = n
+	return v
+}
+
+// Returns a copy of the lazy schema. The inner schema is not copied since it is usually a reference to a parent schema (i.e recursive schemas). The copy resolves it again on first use
+func (v *LazySchema) Clone() *LazySchema {
+	return &LazySchema{fn: v.fn, maxDepth: v.maxDepth}
+}
+
+// Returns a walkable description of the schema. See z.Describe
+func (v *LazySchema) Describe() *SchemaDescription {
+	return Describe(v)
+}
+
+// Parses the data into the destination using the resolved schema
+func (v *LazySchema) Parse(data any, dest any, options ...ExecOption) ZogIssueList {
+	errs := p.NewErrsList()
+	defer errs.Free()
+	ctx := p.NewExecCtx(errs, conf.IssueFormatter)
+	defer ctx.Free()
+	for _, opt := range options {
+		opt(ctx)
+	}
+	path := p.NewPathBuilder()
+	defer path.Free()
+	sctx := ctx.NewSchemaCtx(data, dest, path, v.getType())
+	defer sctx.Free()
+	v.process(sctx)
+
+	return errs.List
+}
+
+// Internal function to process the data
+func (v *LazySchema) process(ctx *p.SchemaCtx) {
+	schema := v.resolve()
+	if !v.enter(ctx) {
+		return
+	}
+	defer v.exit(ctx)
+	schema.process(ctx)
+}
+
+// Validates the data using the resolved schema
+func (v *LazySchema) Validate(dataPtr any, options ...ExecOption) ZogIssueList {
+	errs := p.NewErrsList()
+	defer errs.Free()
+	ctx := p.NewExecCtx(errs, conf.IssueFormatter)
+	defer ctx.Free()
+	for _, opt := range options {
+		opt(ctx)
+	}
+	path := p.NewPathBuilder()
+	defer path.Free()
+	sctx := ctx.NewSchemaCtx(dataPtr, dataPtr, path, v.getType())
+	defer sctx.Free()
+	v.validate(sctx)
+
+	return errs.List
+}
+
+// Internal function to validate the data
+func (v *LazySchema) validate(ctx *p.SchemaCtx) {
+	schema := v.resolve()
+	if !v.enter(ctx) {
+		return
+	}
+	defer v.exit(ctx)
+	schema.validate(ctx)
+}
+
+func (v *LazySchema) resolve() ZogSchema {
+	v.once.Do(func() {
+		v.schema = v.fn()
+	})
+	return v.schema
+}
+
+// increments the lazy depth. Returns false and adds an issue if the max depth was reached
+// The issue uses the execution type because the lazy schema can wrap a schema of any type
+func (v *LazySchema) enter(ctx *p.SchemaCtx) bool {
+	if ctx.LazyDepth >= v.maxDepth {
+		ctx.AddIssue(ctx.Issue().SetCode(zconst.IssueCodeMaxDepth).SetDType(zconst.TypeExecution).SetParams(map[string]any{
+			zconst.IssueCodeMaxDepth: v.maxDepth,
+		}))
+		return false
+	}
+	ctx.LazyDepth++
+	return true
+}
+
+func (v *LazySchema) exit(ctx *p.SchemaCtx) {
+	ctx.LazyDepth--
+}
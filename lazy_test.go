@@ -0,0 +1,133 @@
+package zog
+
+import (
+	"testing"
+
+	"github.com/Oudwins/zog/tutils"
+	"github.com/Oudwins/zog/zconst"
+	"github.com/stretchr/testify/assert"
+)
+
+type Comment struct {
+	Text    string
+	Replies []Comment
+}
+
+type Category struct {
+	Name   string
+	Parent *Category
+}
+
+func commentSchema() *StructSchema {
+	var schema *StructSchema
+	schema = Struct(Shape{
+		"text":    String().Required(),
+		"replies": Slice(Lazy(func() ZogSchema { return schema })),
+	})
+	return schema
+}
+
+func TestLazyParseRecursiveSlice(t *testing.T) {
+	schema := commentSchema()
+	var dest Comment
+	errs := schema.Parse(map[string]any{
+		"text": "root",
+		"replies": []any{
+			map[string]any{"text": "child", "replies": []any{
+				map[string]any{"text": "grandchild"},
+			}},
+			map[string]any{},
+		},
+	}, &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "replies[1].text", errs[0].PathString())
+	assert.Equal(t, "grandchild", dest.Replies[0].Replies[0].Text)
+}
+
+func TestLazyParseRecursivePointer(t *testing.T) {
+	var schema *StructSchema
+	schema = Struct(Shape{
+		"name":   String().Required(),
+		"parent": Ptr(Lazy(func() ZogSchema { return schema })),
+	})
+	var dest Category
+	errs := schema.Parse(map[string]any{
+		"name": "shoes",
+		"parent": map[string]any{
+			"name": "clothing",
+		},
+	}, &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, "clothing", dest.Parent.Name)
+	assert.Nil(t, dest.Parent.Parent)
+}
+
+func TestLazyResolvesOnce(t *testing.T) {
+	calls := 0
+	schema := Lazy(func() ZogSchema {
+		calls++
+		return String().Min(2)
+	})
+	assert.Equal(t, 0, calls)
+	var dest string
+	errs := schema.Parse("ab", &dest)
+	assert.Empty(t, errs)
+	errs = schema.Parse("a", &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, 1, calls)
+}
+
+func TestLazyMaxDepth(t *testing.T) {
+	var schema *StructSchema
+	lazy := Lazy(func() ZogSchema { return schema }).MaxDepth(2)
+	schema = Struct(Shape{
+		"name":   String(),
+		"parent": Ptr(lazy),
+	})
+	var dest Category
+	errs := schema.Parse(map[string]any{
+		"name": "a",
+		"parent": map[string]any{
+			"name": "b",
+			"parent": map[string]any{
+				"name":   "c",
+				"parent": map[string]any{"name": "d"},
+			},
+		},
+	}, &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeMaxDepth, errs[0].Code)
+	assert.Equal(t, zconst.TypeExecution, errs[0].Dtype)
+	assert.Equal(t, "parent.parent.parent", errs[0].PathString())
+	assert.Equal(t, 2, errs[0].Params[zconst.IssueCodeMaxDepth])
+	tutils.VerifyDefaultIssueMessages(t, errs)
+}
+
+func TestLazyValidateCyclicData(t *testing.T) {
+	var schema *StructSchema
+	schema = Struct(Shape{
+		"name":   String().Required(),
+		"parent": Ptr(Lazy(func() ZogSchema { return schema })),
+	})
+	dest := Category{Name: "loop"}
+	dest.Parent = &dest
+	errs := schema.Validate(&dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeMaxDepth, errs[0].Code)
+	assert.Equal(t, zconst.TypeExecution, errs[0].Dtype)
+	assert.Len(t, errs[0].Path, DefaultLazyMaxDepth+1)
+	tutils.VerifyDefaultIssueMessages(t, errs)
+}
+
+func TestLazyValidateRecursiveSlice(t *testing.T) {
+	schema := commentSchema()
+	dest := Comment{
+		Text: "root",
+		Replies: []Comment{
+			{Text: "child", Replies: []Comment{{}}},
+		},
+	}
+	errs := schema.Validate(&dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "replies[0].replies[0].text", errs[0].PathString())
+}
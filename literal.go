@@ -0,0 +1,237 @@
+package zog
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/Oudwins/zog/conf"
+	p "github.com/Oudwins/zog/internals"
+	"github.com/Oudwins/zog/zconst"
+)
+
+var _ ZogSchema = &LiteralSchema[string]{}
+
+type LiteralSchema[T comparable] struct {
+	value      T
+	processors []p.ZProcessor[*T]
+	required   *p.Test[*T]
+	coercer    CoercerFunc
+}
+
+// ! INTERNALS
+
+// Returns the type of the schema
+func (v *LiteralSchema[T]) getType() zconst.ZogType {
+	return zconst.TypeLiteral
+}
+
+// Sets the coercer for the schema
+func (v *LiteralSchema[T]) setCoercer(c CoercerFunc) {
+	v.coercer = c
+}
+
+// Returns a deep copy of the schema. Used to clone inner schemas of complex schemas
+func (v *LiteralSchema[T]) cloneSchema() ZogSchema {
+	return v.Clone()
+}
+
+// Returns the description of the schema. See z.Describe. The value is described as a literal test
+func (v *LiteralSchema[T]) describe(d *describer) *SchemaDescription {
+	desc := d.new(v, v.getType())
+	desc.GoType = v.goType()
+	desc.Required = v.required != nil
+	desc.Tests = describeTests(v.processors)
+	return desc
+}
+
+// ! USER FACING FUNCTIONS
+
+// Creates a literal schema. The value must be exactly equal to value. Useful for version numbers or as the discriminator of struct schemas in a union. Usage:
+//
+//	z.Struct(z.Shape{
+//		"kind":    z.Literal("Invoice").Required(),
+//		"version": z.Literal(2),
+//	})
+//
+// The input is coerced to the type of value (i.e json numbers to int). Inputs that can't be coerced or are not equal to value raise a zconst.IssueCodeLiteral issue. The options apply to that issue
+func Literal[T comparable](value T, options ...TestOption) *LiteralSchema[T] {
+	t, fn := p.Literal(value)
+	p.TestFuncFromBool(fn, &t)
+	for _, opt := range options {
+		opt(&t)
+	}
+	return &LiteralSchema[T]{
+		value:      value,
+		processors: []p.ZProcessor[*T]{&t},
+		coercer:    kindCoercer[T](),
+	}
+}
+
+// returns a coercer to T based on its kind so custom types (i.e type Kind string) are supported
+func kindCoercer[T comparable]() CoercerFunc {
+	typ := reflect.TypeOf(*new(T))
+	var coercer CoercerFunc
+	switch typ.Kind() {
+	case reflect.String:
+		coercer = conf.Coercers.String
+	case reflect.Bool:
+		coercer = conf.Coercers.Bool
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		coercer = conf.Coercers.Int
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		coercer = conf.Coercers.Uint
+	case reflect.Float32, reflect.Float64:
+		coercer = conf.Coercers.Float64
+	}
+	return func(data any) (any, error) {
+		if x, ok := data.(T); ok {
+			return x, nil
+		}
+		if coercer == nil {
+			return nil, fmt.Errorf("input data is an unsupported type to coerce to %s: %v", typ, data)
+		}
+		x, err := coercer(data)
+		if err != nil {
+			return nil, err
+		}
+		val := reflect.ValueOf(x)
+		// Convert wraps values that don't fit in T (i.e 258 -> int8(2))
+		zero := reflect.Zero(typ)
+		switch {
+		case val.CanInt() && zero.OverflowInt(val.Int()),
+			val.CanUint() && zero.OverflowUint(val.Uint()),
+			val.CanFloat() && zero.OverflowFloat(val.Float()):
+			return nil, fmt.Errorf("input data overflows %s: %v", typ, data)
+		}
+		return val.Convert(typ).Interface(), nil
+	}
+}
+
+// Returns the value of the literal
+func (v *LiteralSchema[T]) Value() T {
+	return v.value
+}
+
+// Parse data into destination pointer
+func (v *LiteralSchema[T]) Parse(data any, dest *T, options ...ExecOption) ZogIssueList {
+	errs := p.NewErrsList()
+	defer errs.Free()
+	ctx := p.NewExecCtx(errs, conf.IssueFormatter)
+	defer ctx.Free()
+	for _, opt := range options {
+		opt(ctx)
+	}
+	path := p.NewPathBuilder()
+	defer path.Free()
+	sctx := ctx.NewSchemaCtx(data, dest, path, v.getType())
+	defer sctx.Free()
+	v.process(sctx)
+	return errs.List
+}
+
+// Internal function to process the data
+func (v *LiteralSchema[T]) process(ctx *p.SchemaCtx) {
+	destPtr, ok := ctx.ValPtr.(*T)
+	if !ok {
+		p.Panicf(p.PanicTypeCast, ctx.String(), ctx.DType, ctx.ValPtr)
+	}
+	if !resolveDataProvider(ctx) {
+		return
+	}
+
+	if p.IsParseZeroValue(ctx.Data, ctx) {
+		if v.required != nil {
+			ctx.AddIssue(ctx.IssueFromTest(v.required, *destPtr))
+		}
+		return
+	}
+	x, err := v.coercer(ctx.Data)
+	if err != nil {
+		// the literal issue is more useful than a coerce issue since it says which value is expected
+		ctx.Processor = v.processors[0]
+		ctx.AddIssue(ctx.IssueFromTest(v.processors[0].(p.TestInterface), ctx.Data).SetError(err))
+		return
+	}
+	val, ok := x.(T)
+	if !ok {
+		p.Panicf(p.PanicTypeCastCoercer, ctx.String(), ctx.DType, x)
+	}
+	*destPtr = val
+	v.runProcessors(ctx, destPtr)
+}
+
+// Validate data against schema
+func (v *LiteralSchema[T]) Validate(val *T, options ...ExecOption) ZogIssueList {
+	errs := p.NewErrsList()
+	defer errs.Free()
+	ctx := p.NewExecCtx(errs, conf.IssueFormatter)
+	defer ctx.Free()
+	for _, opt := range options {
+		opt(ctx)
+	}
+	path := p.NewPathBuilder()
+	defer path.Free()
+	sctx := ctx.NewSchemaCtx(val, val, path, v.getType())
+	defer sctx.Free()
+	v.validate(sctx)
+	return errs.List
+}
+
+// Internal function to validate data
+func (v *LiteralSchema[T]) validate(ctx *p.SchemaCtx) {
+	valPtr, ok := ctx.ValPtr.(*T)
+	if !ok {
+		p.Panicf(p.PanicTypeCast, ctx.String(), ctx.DType, ctx.ValPtr)
+	}
+	// zero values are missing values unless the literal itself is the zero value (i.e z.Literal(false))
+	if *valPtr != v.value && p.IsZeroValue(*valPtr) {
+		if v.required != nil {
+			ctx.AddIssue(ctx.IssueFromTest(v.required, *valPtr))
+		}
+		return
+	}
+	v.runProcessors(ctx, valPtr)
+}
+
+func (v *LiteralSchema[T]) runProcessors(ctx *p.SchemaCtx, valPtr *T) {
+	for _, processor := range v.processors {
+		ctx.Processor = processor
+		processor.ZProcess(valPtr, ctx)
+		if ctx.Exit {
+			return
+		}
+	}
+}
+
+// Returns a deep copy of the schema. Tests & required are copied so changing the copy doesn't affect the original
+func (v *LiteralSchema[T]) Clone() *LiteralSchema[T] {
+	return &LiteralSchema[T]{
+		value:      v.value,
+		processors: p.CloneProcessors(v.processors),
+		required:   v.required.Clone(),
+		coercer:    v.coercer,
+	}
+}
+
+// Returns a walkable description of the schema. See z.Describe
+func (v *LiteralSchema[T]) Describe() *SchemaDescription {
+	return Describe(v)
+}
+
+// !MODIFIERS
+
+// marks field as required
+func (v *LiteralSchema[T]) Required(options ...TestOption) *LiteralSchema[T] {
+	r := p.Required[*T]()
+	for _, opt := range options {
+		opt(&r)
+	}
+	v.required = &r
+	return v
+}
+
+// marks field as optional
+func (v *LiteralSchema[T]) Optional() *LiteralSchema[T] {
+	v.required = nil
+	return v
+}
@@ -0,0 +1,153 @@
+package zog
+
+import (
+	"testing"
+
+	"github.com/Oudwins/zog/tutils"
+	"github.com/Oudwins/zog/zconst"
+	"github.com/stretchr/testify/assert"
+)
+
+type literalKind string
+
+func TestLiteralParse(t *testing.T) {
+	var version int
+	errs := Literal(2).Parse(float64(2), &version)
+	assert.Empty(t, errs)
+	assert.Equal(t, 2, version)
+
+	errs = Literal(2).Parse(3, &version)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeLiteral, errs[0].Code)
+	assert.Equal(t, zconst.TypeLiteral, errs[0].Dtype)
+	assert.Equal(t, 2, errs[0].Params[zconst.IssueCodeLiteral])
+	tutils.VerifyDefaultIssueMessages(t, errs)
+
+	// inputs that can't be coerced are reported as literal issues
+	errs = Literal(2).Parse("two", &version)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeLiteral, errs[0].Code)
+	assert.Equal(t, "two", errs[0].Value)
+	assert.NotNil(t, errs[0].Err)
+
+	var kind literalKind
+	errs = Literal(literalKind("Invoice")).Parse("Invoice", &kind)
+	assert.Empty(t, errs)
+	assert.Equal(t, literalKind("Invoice"), kind)
+
+	var b bool
+	errs = Literal(false).Parse("false", &b)
+	assert.Empty(t, errs)
+	errs = Literal(false).Parse(true, &b)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "must be false", errs[0].Message)
+}
+
+func TestLiteralOverflow(t *testing.T) {
+	// 258 would wrap to int8(2)
+	var i int8
+	errs := Literal(int8(2)).Parse(258, &i)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeLiteral, errs[0].Code)
+	assert.ErrorContains(t, errs[0].Err, "overflows int8")
+	assert.Equal(t, int8(0), i)
+
+	var u uint8
+	errs = Literal(uint8(1)).Parse(uint(257), &u)
+	assert.Len(t, errs, 1)
+	assert.NotNil(t, errs[0].Err)
+
+	var f float32
+	errs = Literal(float32(1)).Parse(1e300, &f)
+	assert.Len(t, errs, 1)
+	assert.NotNil(t, errs[0].Err)
+
+	errs = Literal(int8(-128)).Parse(-128, &i)
+	assert.Empty(t, errs)
+	assert.Equal(t, int8(-128), i)
+}
+
+func TestLiteralRequired(t *testing.T) {
+	var version int
+	errs := Literal(2).Parse(nil, &version)
+	assert.Empty(t, errs)
+	assert.Equal(t, 0, version)
+
+	errs = Literal(2).Required().Parse(nil, &version)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeRequired, errs[0].Code)
+	tutils.VerifyDefaultIssueMessages(t, errs)
+}
+
+func TestLiteralMessageOption(t *testing.T) {
+	var kind string
+	errs := Literal("Invoice", Message("not an invoice")).Parse("Receipt", &kind)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "not an invoice", errs[0].Message)
+}
+
+func TestLiteralValidate(t *testing.T) {
+	version := 3
+	errs := Literal(2).Validate(&version)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeLiteral, errs[0].Code)
+
+	version = 0
+	errs = Literal(2).Validate(&version)
+	assert.Empty(t, errs)
+	errs = Literal(2).Required().Validate(&version)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeRequired, errs[0].Code)
+
+	// the zero value is valid if it is the literal
+	b := false
+	errs = Literal(false).Required().Validate(&b)
+	assert.Empty(t, errs)
+}
+
+func TestLiteralAsDiscriminator(t *testing.T) {
+	type Document struct {
+		Kind   string
+		Number string
+		Total  float64
+	}
+	invoice := Struct(Shape{
+		"kind":   Literal("Invoice").Required(),
+		"number": String().Required(),
+	})
+	receipt := Struct(Shape{
+		"kind":  Literal("Receipt").Required(),
+		"total": Float64().Required(),
+	})
+	schema := Union(invoice, receipt)
+
+	var dest Document
+	errs := schema.Parse(map[string]any{"kind": "Receipt", "total": 10.5}, &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, Document{Kind: "Receipt", Total: 10.5}, dest)
+
+	du := DiscriminatedUnion("kind", map[string]*StructSchema{"Invoice": invoice, "Receipt": receipt})
+	dest = Document{}
+	errs = du.Parse(map[string]any{"kind": "Invoice", "number": "F-1"}, &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, Document{Kind: "Invoice", Number: "F-1"}, dest)
+
+	dest = Document{Kind: "Invoice"}
+	errs = receipt.Validate(&dest)
+	assert.Len(t, errs, 2)
+	assert.Equal(t, zconst.IssueCodeLiteral, errs[0].Code)
+	assert.Equal(t, []string{"kind"}, errs[0].Path)
+}
+
+func TestLiteralDescribeAndClone(t *testing.T) {
+	schema := Literal(2).Required()
+	desc := schema.Describe()
+	assert.Equal(t, zconst.TypeLiteral, desc.Type)
+	assert.True(t, desc.Required)
+	assert.Equal(t, []TestDescription{{IssueCode: zconst.IssueCodeLiteral, Params: map[string]any{zconst.IssueCodeLiteral: 2}}}, desc.Tests)
+
+	clone := schema.Clone().Optional()
+	assert.Equal(t, 2, clone.Value())
+	assert.True(t, schema.Describe().Required)
+	assert.False(t, clone.Describe().Required)
+}
@@ -0,0 +1,370 @@
+package zog
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/Oudwins/zog/conf"
+	p "github.com/Oudwins/zog/internals"
+	"github.com/Oudwins/zog/zconst"
+)
+
+// ! INTERNALS
+var _ ComplexZogSchema = &MapSchema{}
+
+type MapSchema struct {
+	processors []p.ZProcessor[any]
+	keySchema  ZogSchema
+	schema     ZogSchema
+	required   *p.Test[any]
+	defaultVal any
+	coercer    conf.CoercerFunc
+}
+
+// Returns the type of the schema
+func (v *MapSchema) getType() zconst.ZogType {
+	return zconst.TypeMap
+}
+
+// Sets the coercer for the schema
+func (v *MapSchema) setCoercer(c conf.CoercerFunc) {
+	v.coercer = c
+}
+
+// Returns a deep copy of the schema. Used to clone inner schemas of complex schemas
+func (v *MapSchema) cloneSchema() ZogSchema {
+	return v.Clone()
+}
+
+// Returns the description of the schema. See z.Describe
+func (v *MapSchema) describe(d *describer) *SchemaDescription {
+	desc := d.new(v, v.getType())
+	desc.Required = v.required != nil
+	desc.Default = v.defaultVal
+	desc.Tests = describeTests(v.processors)
+	desc.Key = d.schema(v.keySchema)
+	desc.Schema = d.schema(v.schema)
+	return desc
+}
+
+// ! USER FACING FUNCTIONS
+
+// Creates a map schema. That is a Zog representation of a map[K]V.
+// It takes a ZogSchema for the keys and a ZogSchema for the values. Every entry in the map is validated against them.
+func Map(keySchema ZogSchema, valueSchema ZogSchema, opts ...SchemaOption) *MapSchema {
+	s := &MapSchema{
+		keySchema: keySchema,
+		schema:    valueSchema,
+		coercer:   conf.Coercers.Map, // default coercer
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Parses data into a map. Supports data = map[K]any or anything that can be turned into a DataProvider (i.e zjson, zhttp). dest must be a pointer to a map
+func (v *MapSchema) Parse(data any, dest any, options ...ExecOption) ZogIssueList {
+	errs := p.NewErrsList()
+	defer errs.Free()
+	ctx := p.NewExecCtx(errs, conf.IssueFormatter)
+	defer ctx.Free()
+	for _, opt := range options {
+		opt(ctx)
+	}
+	path := p.NewPathBuilder()
+	defer path.Free()
+	sctx := ctx.NewSchemaCtx(data, dest, path, v.getType())
+	defer sctx.Free()
+	v.process(sctx)
+
+	return errs.List
+}
+
+// Internal function to process the data
+func (v *MapSchema) process(ctx *p.SchemaCtx) {
+	// 1. unwrap data providers so we can iterate over the underlying map
+	if !resolveDataProvider(ctx) {
+		return
+	}
+
+	// 2. cast data to map & handle default/required
+	isZeroVal := p.IsParseZeroValue(ctx.Data, ctx)
+	var refVal reflect.Value
+
+	if isZeroVal {
+		if v.defaultVal != nil {
+			refVal = reflect.ValueOf(v.defaultVal)
+		} else if v.required == nil {
+			return
+		} else {
+			// REQUIRED & ZERO VALUE
+			ctx.AddIssue(ctx.IssueFromTest(v.required, ctx.Data))
+			return
+		}
+	} else {
+		m, err := v.coercer(ctx.Data)
+		if err != nil {
+			ctx.AddIssue(ctx.IssueFromCoerce(err))
+			return
+		}
+		refVal = reflect.ValueOf(m)
+	}
+
+	destVal := reflect.ValueOf(ctx.ValPtr).Elem()
+	destTyp := destVal.Type()
+	newMap := reflect.MakeMapWithSize(destTyp, refVal.Len())
+
+	// 3.1 tests for map entries
+	subCtx := ctx.NewSchemaCtx(ctx.Data, ctx.ValPtr, ctx.Path, v.schema.getType())
+	defer subCtx.Free()
+	for _, key := range sortedMapKeys(refVal) {
+		if ctx.Stopped() {
+			return
+		}
+		k := mapKeyPath(key)
+		subCtx.Path.Push(&k)
+
+		keyPtr := reflect.New(destTyp.Key())
+		keyOk := v.processKey(subCtx, key.Interface(), keyPtr.Interface())
+
+		valPtr := reflect.New(destTyp.Elem())
+		subCtx.Data = refVal.MapIndex(key).Interface()
+		subCtx.ValPtr = valPtr.Interface()
+		subCtx.DType = v.schema.getType()
+		subCtx.Exit = false
+		v.schema.process(subCtx)
+
+		// invalid keys are not added to the map since their value would overwrite the entry of the zero key
+		if keyOk {
+			newMap.SetMapIndex(keyPtr.Elem(), valPtr.Elem())
+		}
+		subCtx.Path.Pop()
+	}
+	destVal.Set(newMap)
+
+	for _, processor := range v.processors {
+		ctx.Processor = processor
+		processor.ZProcess(ctx.ValPtr, ctx)
+		if ctx.Exit {
+			return
+		}
+	}
+}
+
+// Parses a map key. The issues of the key schema are collected into a separate list so we know if the key is valid, then added to the execution
+func (v *MapSchema) processKey(ctx *p.SchemaCtx, data any, keyPtr any) bool {
+	errs := p.NewErrsList()
+	defer errs.Free()
+	execCtx := ctx.ExecCtx.Fork(errs)
+	defer execCtx.Free()
+	keyCtx := execCtx.NewSchemaCtx(data, keyPtr, ctx.Path, v.keySchema.getType())
+	defer keyCtx.Free()
+
+	v.keySchema.process(keyCtx)
+	for _, issue := range errs.List {
+		ctx.AddIssue(issue)
+	}
+	return errs.IsEmpty()
+}
+
+// Validates a map pointer
+func (v *MapSchema) Validate(data any, options ...ExecOption) ZogIssueList {
+	errs := p.NewErrsList()
+	defer errs.Free()
+	ctx := p.NewExecCtx(errs, conf.IssueFormatter)
+	defer ctx.Free()
+	for _, opt := range options {
+		opt(ctx)
+	}
+	path := p.NewPathBuilder()
+	defer path.Free()
+	sctx := ctx.NewSchemaCtx(data, data, path, v.getType())
+	defer sctx.Free()
+	v.validate(sctx)
+	return errs.List
+}
+
+// Internal function to validate the data
+func (v *MapSchema) validate(ctx *p.SchemaCtx) {
+	refVal := reflect.ValueOf(ctx.ValPtr).Elem() // we use this to set the value to the ptr. But we still reference the ptr everywhere.
+
+	// 2. handle default/required
+	if refVal.Len() == 0 {
+		if v.defaultVal != nil {
+			refVal.Set(deepCopy(reflect.ValueOf(v.defaultVal)))
+		} else if v.required == nil {
+			return
+		} else {
+			// REQUIRED & ZERO VALUE
+			ctx.AddIssue(ctx.IssueFromTest(v.required, ctx.ValPtr))
+			return
+		}
+	}
+
+	// 3.1 tests for map entries. Map values are not addressable so we validate a copy and write it back
+	typ := refVal.Type()
+	subCtx := ctx.NewValidateSchemaCtx(ctx.ValPtr, ctx.Path, v.schema.getType())
+	defer subCtx.Free()
+	for _, key := range sortedMapKeys(refVal) {
+		if ctx.Stopped() {
+			return
+		}
+		k := mapKeyPath(key)
+		subCtx.Path.Push(&k)
+
+		keyPtr := reflect.New(typ.Key())
+		keyPtr.Elem().Set(key)
+		subCtx.ValPtr = keyPtr.Interface()
+		subCtx.DType = v.keySchema.getType()
+		subCtx.Exit = false
+		v.keySchema.validate(subCtx)
+
+		valPtr := reflect.New(typ.Elem())
+		valPtr.Elem().Set(refVal.MapIndex(key))
+		subCtx.ValPtr = valPtr.Interface()
+		subCtx.DType = v.schema.getType()
+		subCtx.Exit = false
+		v.schema.validate(subCtx)
+
+		if !keyPtr.Elem().Equal(key) {
+			refVal.SetMapIndex(key, reflect.Value{})
+		}
+		refVal.SetMapIndex(keyPtr.Elem(), valPtr.Elem())
+		subCtx.Path.Pop()
+	}
+
+	for _, processor := range v.processors {
+		ctx.Processor = processor
+		processor.ZProcess(ctx.ValPtr, ctx)
+		if ctx.Exit {
+			return
+		}
+	}
+}
+
+// Adds transform function to schema.
+func (v *MapSchema) Transform(transform Transform[any]) *MapSchema {
+	v.processors = append(v.processors, &p.TransformProcessor[any]{
+		Transform: p.Transform[any](transform),
+	})
+	return v
+}
+
+// Returns a deep copy of the schema. The key & value schemas, tests, transforms & required are copied so changing the copy doesn't affect the original. The default value is deep copied too
+func (v *MapSchema) Clone() *MapSchema {
+	return &MapSchema{
+		processors: p.CloneProcessors(v.processors),
+		keySchema:  v.keySchema.cloneSchema(),
+		schema:     v.schema.cloneSchema(),
+		required:   v.required.Clone(),
+		defaultVal: cloneValue(v.defaultVal),
+		coercer:    v.coercer,
+	}
+}
+
+// Returns a walkable description of the schema. See z.Describe
+func (v *MapSchema) Describe() *SchemaDescription {
+	return Describe(v)
+}
+
+// !MODIFIERS
+
+// marks field as required
+func (v *MapSchema) Required(options ...TestOption) *MapSchema {
+	r := p.Required[any]()
+	for _, opt := range options {
+		opt(&r)
+	}
+	v.required = &r
+	return v
+}
+
+// marks field as optional
+func (v *MapSchema) Optional() *MapSchema {
+	v.required = nil
+	return v
+}
+
+// sets the default value. The destination gets a deep copy so changing it doesn't change the default
+func (v *MapSchema) Default(val any) *MapSchema {
+	v.defaultVal = val
+	return v
+}
+
+// !TESTS
+
+// custom test function call it -> schema.Test(t z.Test)
+func (v *MapSchema) Test(t Test[any]) *MapSchema {
+	x := p.Test[any](t)
+	v.processors = append(v.processors, &x)
+	return v
+}
+
+// Create a custom test function for the schema. This is similar to Zod's `.refine()` method.
+func (v *MapSchema) TestFunc(testFunc BoolTFunc[any], opts ...TestOption) *MapSchema {
+	t := p.NewTestFunc("", p.BoolTFunc[any](testFunc), opts...)
+	v.Test(Test[any](*t))
+	return v
+}
+
+// Minimum number of entries
+func (v *MapSchema) Min(n int, options ...TestOption) *MapSchema {
+	t, fn := mapLenTest(zconst.IssueCodeMin, n, func(l int) bool { return l >= n })
+	return v.addTest(&t, fn, options...)
+}
+
+// Maximum number of entries
+func (v *MapSchema) Max(n int, options ...TestOption) *MapSchema {
+	t, fn := mapLenTest(zconst.IssueCodeMax, n, func(l int) bool { return l <= n })
+	return v.addTest(&t, fn, options...)
+}
+
+// Exact number of entries
+func (v *MapSchema) Len(n int, options ...TestOption) *MapSchema {
+	t, fn := mapLenTest(zconst.IssueCodeLen, n, func(l int) bool { return l == n })
+	return v.addTest(&t, fn, options...)
+}
+
+func mapLenTest(code zconst.ZogIssueCode, n int, check func(l int) bool) (p.Test[any], p.BoolTFunc[any]) {
+	fn := func(val any, ctx Ctx) bool {
+		rv := reflect.ValueOf(val).Elem()
+		if rv.Kind() != reflect.Map {
+			return false
+		}
+		return check(rv.Len())
+	}
+
+	t := p.Test[any]{
+		IssueCode: code,
+		Params:    make(map[string]any, 1),
+	}
+	t.Params[code] = n
+	return t, fn
+}
+
+func (v *MapSchema) addTest(t *p.Test[any], fn p.BoolTFunc[any], options ...TestOption) *MapSchema {
+	p.TestFuncFromBool(fn, t)
+
+	for _, opt := range options {
+		opt(t)
+	}
+
+	v.processors = append(v.processors, t)
+	return v
+}
+
+// returns the keys of a map sorted by their string representation so issues are reported in a stable order
+func sortedMapKeys(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+	})
+	return keys
+}
+
+// returns the path segment for a map entry. i.e settings[theme]
+func mapKeyPath(key reflect.Value) string {
+	return fmt.Sprintf("[%v]", key.Interface())
+}
@@ -0,0 +1,172 @@
+package zog
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Oudwins/zog/parsers/zjson"
+	"github.com/Oudwins/zog/tutils"
+	"github.com/Oudwins/zog/zconst"
+	"github.com/stretchr/testify/assert"
+)
+
+type Setting struct {
+	Value   string
+	Enabled bool
+}
+
+func TestMapParse(t *testing.T) {
+	schema := Map(String(), Int())
+	var dest map[string]int
+	errs := schema.Parse(map[string]any{"a": 1, "b": "2"}, &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, dest)
+}
+
+func TestMapParseKeyCoercion(t *testing.T) {
+	schema := Map(Int(), String())
+	var dest map[int]string
+	errs := schema.Parse(map[string]any{"1": "one", "2": "two"}, &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, map[int]string{1: "one", 2: "two"}, dest)
+
+	errs = schema.Parse(map[string]any{"x": "one"}, &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeCoerce, errs[0].Code)
+	assert.Equal(t, []string{"[x]"}, errs[0].Path)
+}
+
+func TestMapParseOfStructs(t *testing.T) {
+	type Config struct {
+		Settings map[string]Setting
+	}
+	schema := Struct(Shape{
+		"settings": Map(String(), Struct(Shape{
+			"value":   String().Required(),
+			"enabled": Bool(),
+		})),
+	})
+	var dest Config
+	errs := schema.Parse(map[string]any{
+		"settings": map[string]any{
+			"theme": map[string]any{"value": "dark", "enabled": true},
+			"lang":  map[string]any{},
+		},
+	}, &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "settings[lang].value", errs[0].PathString())
+	assert.Equal(t, Setting{Value: "dark", Enabled: true}, dest.Settings["theme"])
+	tutils.VerifyDefaultIssueMessages(t, errs)
+}
+
+func TestMapParseOfSlices(t *testing.T) {
+	schema := Map(String(), Slice(String().Min(2)))
+	var dest map[string][]string
+	errs := schema.Parse(map[string]any{
+		"a": []any{"foo", "bar"},
+		"b": []any{"x"},
+	}, &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "[b][0]", errs[0].PathString())
+	assert.Equal(t, []string{"foo", "bar"}, dest["a"])
+}
+
+func TestMapParseKeyValidation(t *testing.T) {
+	schema := Map(String().Min(3), Int())
+	var dest map[string]int
+	errs := schema.Parse(map[string]any{"ab": 1, "abc": 2}, &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "[ab]", errs[0].PathString())
+	assert.Equal(t, zconst.IssueCodeMin, errs[0].Code)
+	assert.Equal(t, map[string]int{"abc": 2}, dest)
+}
+
+func TestMapParseInvalidKeyDoesNotOverwriteZeroKey(t *testing.T) {
+	schema := Map(Int(), String())
+	var dest map[int]string
+	errs := schema.Parse(map[string]any{"0": "zero", "x": "invalid"}, &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeCoerce, errs[0].Code)
+	assert.Equal(t, map[int]string{0: "zero"}, dest)
+}
+
+func TestMapParseRequired(t *testing.T) {
+	schema := Map(String(), Int())
+	var dest map[string]int
+	errs := schema.Parse(nil, &dest)
+	assert.Empty(t, errs)
+	assert.Nil(t, dest)
+
+	schema.Required()
+	errs = schema.Parse(nil, &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeRequired, errs[0].Code)
+	tutils.VerifyDefaultIssueMessages(t, errs)
+
+	schema.Optional()
+	errs = schema.Parse(nil, &dest)
+	assert.Empty(t, errs)
+}
+
+func TestMapParseDefault(t *testing.T) {
+	schema := Map(String(), Int()).Default(map[string]int{"a": 1})
+	var dest map[string]int
+	errs := schema.Parse(nil, &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, map[string]int{"a": 1}, dest)
+}
+
+func TestMapParseCoerceError(t *testing.T) {
+	schema := Map(String(), Int())
+	var dest map[string]int
+	errs := schema.Parse("not a map", &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeCoerce, errs[0].Code)
+	assert.Equal(t, zconst.TypeMap, errs[0].Dtype)
+	tutils.VerifyDefaultIssueMessages(t, errs)
+}
+
+func TestMapParseDataProvider(t *testing.T) {
+	schema := Map(String(), Int())
+	var dest map[string]int
+	errs := schema.Parse(zjson.Decode(strings.NewReader(`{"a": 1, "b": 2}`)), &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, dest)
+}
+
+func TestMapParseLenTests(t *testing.T) {
+	var dest map[string]int
+	data := map[string]any{"a": 1, "b": 2}
+
+	errs := Map(String(), Int()).Min(3).Parse(data, &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeMin, errs[0].Code)
+	assert.Equal(t, "map must contain at least 3 entries", errs[0].Message)
+
+	errs = Map(String(), Int()).Max(1).Parse(data, &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeMax, errs[0].Code)
+
+	errs = Map(String(), Int()).Len(2).Parse(data, &dest)
+	assert.Empty(t, errs)
+
+	errs = Map(String(), Int()).Len(1, Message("custom")).Parse(data, &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "custom", errs[0].Message)
+}
+
+func TestMapParseTransform(t *testing.T) {
+	schema := Map(String(), Int()).Transform(func(val any, ctx Ctx) error {
+		m := val.(*map[string]int)
+		delete(*m, "drop")
+		return nil
+	})
+	var dest map[string]int
+	errs := schema.Parse(map[string]any{"keep": 1, "drop": 2}, &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, map[string]int{"keep": 1}, dest)
+}
+
+func TestMapGetType(t *testing.T) {
+	assert.Equal(t, zconst.TypeMap, Map(String(), Int()).getType())
+}
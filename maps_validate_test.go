@@ -0,0 +1,101 @@
+package zog
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Oudwins/zog/tutils"
+	"github.com/Oudwins/zog/zconst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateMap(t *testing.T) {
+	schema := Map(String().Min(2), Int().GT(0))
+	dest := map[string]int{"ab": 1, "cd": 2}
+	errs := schema.Validate(&dest)
+	assert.Empty(t, errs)
+
+	dest = map[string]int{"a": 1, "cd": -1}
+	errs = schema.Validate(&dest)
+	assert.Len(t, errs, 2)
+	assert.Equal(t, "[a]", errs[0].PathString())
+	assert.Equal(t, zconst.TypeString, errs[0].Dtype)
+	assert.Equal(t, "[cd]", errs[1].PathString())
+	assert.Equal(t, zconst.TypeNumber, errs[1].Dtype)
+	tutils.VerifyDefaultIssueMessages(t, errs)
+}
+
+func TestValidateMapRequired(t *testing.T) {
+	schema := Map(String(), Int())
+	dest := map[string]int{}
+	errs := schema.Validate(&dest)
+	assert.Empty(t, errs)
+
+	schema.Required()
+	errs = schema.Validate(&dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeRequired, errs[0].Code)
+	tutils.VerifyDefaultIssueMessages(t, errs)
+}
+
+func TestValidateMapDefault(t *testing.T) {
+	schema := Map(String(), Int()).Default(map[string]int{"a": 1})
+	var dest map[string]int
+	errs := schema.Validate(&dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, map[string]int{"a": 1}, dest)
+}
+
+func TestValidateMapDefaultIsCopied(t *testing.T) {
+	schema := Map(String(), Int()).Default(map[string]int{"a": 1})
+	var dest map[string]int
+	errs := schema.Validate(&dest)
+	assert.Empty(t, errs)
+	dest["a"] = 2
+
+	var dest2 map[string]int
+	errs = schema.Validate(&dest2)
+	assert.Empty(t, errs)
+	assert.Equal(t, map[string]int{"a": 1}, dest2)
+}
+
+func TestValidateMapTransformsEntries(t *testing.T) {
+	schema := Map(String().Trim(), String().Transform(func(val *string, ctx Ctx) error {
+		*val = strings.ToUpper(*val)
+		return nil
+	}))
+	dest := map[string]string{" a ": "x", "b": "y"}
+	errs := schema.Validate(&dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, map[string]string{"a": "X", "b": "Y"}, dest)
+}
+
+func TestValidateMapOfStructs(t *testing.T) {
+	schema := Map(String(), Struct(Shape{
+		"value": String().Required(),
+	}))
+	dest := map[string]Setting{
+		"theme": {Value: "dark"},
+		"lang":  {},
+	}
+	errs := schema.Validate(&dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "[lang].value", errs[0].PathString())
+}
+
+func TestValidateMapLenTests(t *testing.T) {
+	dest := map[string]int{"a": 1, "b": 2}
+
+	errs := Map(String(), Int()).Min(3).Validate(&dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeMin, errs[0].Code)
+
+	errs = Map(String(), Int()).Max(1).Validate(&dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeMax, errs[0].Code)
+
+	errs = Map(String(), Int()).Len(3).Validate(&dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeLen, errs[0].Code)
+	tutils.VerifyDefaultIssueMessages(t, errs)
+}
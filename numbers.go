@@ -36,6 +36,22 @@ func (v *NumberSchema[T]) setCoercer(c CoercerFunc) {
 	v.coercer = c
 }
 
+// Returns a deep copy of the schema. Used to clone inner schemas of complex schemas
+func (v *NumberSchema[T]) cloneSchema() ZogSchema {
+	return v.Clone()
+}
+
+// Returns the description of the schema. See z.Describe
+func (v *NumberSchema[T]) describe(d *describer) *SchemaDescription {
+	desc := d.new(v, v.getType())
+	desc.GoType = v.goType()
+	desc.Required = v.required != nil
+	desc.Default = derefValue(v.defaultVal)
+	desc.Catch = derefValue(v.catch)
+	desc.Tests = describeTests(v.processors)
+	return desc
+}
+
 // ! USER FACING FUNCTIONS
 
 // Deprecated: Use Float64 instead
@@ -234,6 +250,23 @@ func (v *NumberSchema[T]) Transform(transform p.Transform[*T]) *NumberSchema[T]
 	return v
 }
 
+// Returns a deep copy of the schema. Tests, transforms, required, default & catch values are copied so changing the copy doesn't affect the original
+func (v *NumberSchema[T]) Clone() *NumberSchema[T] {
+	return &NumberSchema[T]{
+		processors: p.CloneProcessors(v.processors),
+		defaultVal: clonePtr(v.defaultVal),
+		required:   v.required.Clone(),
+		catch:      clonePtr(v.catch),
+		coercer:    v.coercer,
+		isNot:      v.isNot,
+	}
+}
+
+// Returns a walkable description of the schema. See z.Describe
+func (v *NumberSchema[T]) Describe() *SchemaDescription {
+	return Describe(v)
+}
+
 // ! MODIFIERS
 
 // marks field as required
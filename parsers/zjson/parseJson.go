@@ -27,14 +27,14 @@ var (
 	jsonTag string = "json"
 )
 
-// Decodes JSON data. Does not support json arrays or primitives
+// Decodes JSON data. Objects can be parsed by struct & map schemas. Arrays & primitives by the schemas for those types (i.e z.Slice(z.Struct(...)) for a json array of objects)
 /*
 - "null" -> nil -> Not accepted by zhttp -> errs["zconst.ISSUE_KEY_ROOT"]-> required issue
 - "{}" -> okay -> map[]{}
 - "" -> parsing error -> errs["zconst.ISSUE_KEY_ROOT"]-> parsing error
 - "1213" -> zhttp -> plain value
-  - struct schema -> hey this valid input
-  - "string is not an object"
+  - struct schema -> coerce issue
+  - number schema -> 1213
 */
 func Decode(r io.Reader) p.DpFactory {
 	return func() (p.DataProvider, *p.ZogIssue) {
@@ -42,15 +42,25 @@ func Decode(r io.Reader) p.DpFactory {
 		if ok {
 			defer closer.Close()
 		}
-		var m map[string]any
+		var data any
 		decod := json.NewDecoder(r)
-		err := decod.Decode(&m)
+		// numbers are kept as json.Number so 64 bit integers are not rounded to float64. The coercers parse them into the schema type
+		decod.UseNumber()
+		err := decod.Decode(&data)
 		if err != nil {
 			return nil, &p.ZogIssue{Code: zconst.IssueCodeInvalidJSON, Err: err}
 		}
-		if m == nil {
+		switch v := data.(type) {
+		case nil:
 			return nil, &p.ZogIssue{Code: zconst.IssueCodeInvalidJSON, Err: errors.New("nill json body")}
+		case map[string]any:
+			if len(v) == 0 {
+				// keeps the map so map schemas parse an empty map
+				return &p.EmptyDataProvider{Underlying: v}, nil
+			}
+			return p.NewMapDataProvider(v, &jsonTag), nil
+		default:
+			return &p.ValueDataProvider{Value: v}, nil
 		}
-		return p.NewMapDataProvider(m, &jsonTag), nil
 	}
 }
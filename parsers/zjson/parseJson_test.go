@@ -0,0 +1,179 @@
+package zjson
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	z "github.com/Oudwins/zog"
+	p "github.com/Oudwins/zog/internals"
+	"github.com/Oudwins/zog/zconst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeObject(t *testing.T) {
+	dp, err := Decode(strings.NewReader(`{"name": "zog"}`))()
+	assert.Nil(t, err)
+	assert.IsType(t, &p.MapDataProvider[any]{}, dp)
+	assert.Equal(t, "zog", dp.Get("name"))
+
+	type User struct {
+		Name string `json:"name"`
+	}
+	var u User
+	errs := z.Struct(z.Shape{"name": z.String().Required()}).Parse(Decode(strings.NewReader(`{"name": "zog"}`)), &u)
+	assert.Empty(t, errs)
+	assert.Equal(t, "zog", u.Name)
+}
+
+func TestDecodeEmptyObject(t *testing.T) {
+	dp, err := Decode(strings.NewReader(`{}`))()
+	assert.Nil(t, err)
+	assert.Equal(t, &p.EmptyDataProvider{Underlying: map[string]any{}}, dp)
+
+	var m map[string]int
+	errs := z.Map(z.String(), z.Int()).Parse(Decode(strings.NewReader(`{}`)), &m)
+	assert.Empty(t, errs)
+	assert.Equal(t, map[string]int{}, m)
+}
+
+func TestDecodeArray(t *testing.T) {
+	dp, err := Decode(strings.NewReader(`[{"value": 1}, {"value": 2}]`))()
+	assert.Nil(t, err)
+	assert.IsType(t, &p.ValueDataProvider{}, dp)
+	assert.Nil(t, dp.Get("value"))
+	assert.Len(t, dp.GetUnderlying(), 2)
+
+	type Item struct {
+		Value int `json:"value"`
+	}
+	var items []Item
+	errs := z.Slice(z.Struct(z.Shape{"value": z.Int().Required()})).Parse(Decode(strings.NewReader(`[{"value": 1}, {"value": 2}]`)), &items)
+	assert.Empty(t, errs)
+	assert.Equal(t, []Item{{Value: 1}, {Value: 2}}, items)
+
+	// arrays are not objects so struct schemas fail to coerce them
+	var item Item
+	errs = z.Struct(z.Shape{"value": z.Int()}).Parse(Decode(strings.NewReader(`[1]`)), &item)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeCoerce, errs[0].Code)
+}
+
+func TestDecodePrimitive(t *testing.T) {
+	dp, err := Decode(strings.NewReader(`"hello"`))()
+	assert.Nil(t, err)
+	assert.Equal(t, &p.ValueDataProvider{Value: "hello"}, dp)
+
+	var s string
+	errs := z.String().Parse(Decode(strings.NewReader(`"hello"`)), &s)
+	assert.Empty(t, errs)
+	assert.Equal(t, "hello", s)
+
+	var n int
+	errs = z.Int().Parse(Decode(strings.NewReader(`1213`)), &n)
+	assert.Empty(t, errs)
+	assert.Equal(t, 1213, n)
+}
+
+func TestDecodeNull(t *testing.T) {
+	dp, err := Decode(strings.NewReader(`null`))()
+	assert.Nil(t, dp)
+	assert.Equal(t, zconst.IssueCodeInvalidJSON, err.Code)
+}
+
+func TestDecodeInvalidBody(t *testing.T) {
+	for _, body := range []string{``, `{"name": `, `not json`} {
+		dp, err := Decode(strings.NewReader(body))()
+		assert.Nil(t, dp, body)
+		assert.Equal(t, zconst.IssueCodeInvalidJSON, err.Code, body)
+		assert.NotNil(t, err.Err, body)
+	}
+
+	type User struct {
+		Name string `json:"name"`
+	}
+	var u User
+	errs := z.Struct(z.Shape{"name": z.String()}).Parse(Decode(strings.NewReader(`{`)), &u)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeInvalidJSON, errs[0].Code)
+}
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) {
+	return 0, errors.New("read failed")
+}
+
+func TestDecodeReadError(t *testing.T) {
+	dp, err := Decode(errReader{})()
+	assert.Nil(t, dp)
+	assert.Equal(t, zconst.IssueCodeInvalidJSON, err.Code)
+	assert.EqualError(t, err.Err, "read failed")
+}
+
+func TestDecodeKeepsNumbersAsJSONNumber(t *testing.T) {
+	dp, err := Decode(strings.NewReader(`{"id": 9007199254740993, "price": 10.5}`))()
+	assert.Nil(t, err)
+	assert.Equal(t, json.Number("9007199254740993"), dp.Get("id"))
+	assert.Equal(t, json.Number("10.5"), dp.Get("price"))
+
+	type Order struct {
+		ID    int64   `json:"id"`
+		Price float64 `json:"price"`
+	}
+	var o Order
+	errs := z.Struct(z.Shape{"iD": z.Int64(), "price": z.Float64()}).Parse(Decode(strings.NewReader(`{"id": 9007199254740993, "price": 10.5}`)), &o)
+	assert.Empty(t, errs)
+	assert.Equal(t, Order{ID: 9007199254740993, Price: 10.5}, o)
+}
+
+func TestDecodeJSONNumberInUserFunctions(t *testing.T) {
+	// preprocess functions receive the raw json value
+	type Product struct {
+		Cents int `json:"price"`
+	}
+	var seen any
+	var product Product
+	errs := z.Struct(z.Shape{"cents": z.Preprocess(func(data any, ctx z.Ctx) (int, error) {
+		seen = data
+		n, ok := data.(json.Number)
+		if !ok {
+			return 0, errors.New("expected a number")
+		}
+		f, err := n.Float64()
+		return int(f * 100), err
+	}, z.Int())}).Parse(Decode(strings.NewReader(`{"price": 10.5}`)), &product)
+	assert.Empty(t, errs)
+	assert.Equal(t, json.Number("10.5"), seen)
+	assert.Equal(t, 1050, product.Cents)
+
+	// passthrough collects the raw json values
+	type Item struct {
+		Name  string         `json:"name"`
+		Extra map[string]any `json:"-"`
+	}
+	var item Item
+	errs = z.Struct(z.Shape{"name": z.String()}).Passthrough("Extra").Parse(Decode(strings.NewReader(`{"name": "a", "qty": 3}`)), &item)
+	assert.Empty(t, errs)
+	assert.Equal(t, map[string]any{"qty": json.Number("3")}, item.Extra)
+}
+
+func TestDecodeJSONNumberCoercion(t *testing.T) {
+	var b bool
+	errs := z.Bool().Parse(Decode(strings.NewReader(`1`)), &b)
+	assert.Empty(t, errs)
+	assert.True(t, b)
+
+	var tm time.Time
+	errs = z.Time().Parse(Decode(strings.NewReader(`1733007600`)), &tm)
+	assert.Empty(t, errs)
+	assert.Equal(t, time.Unix(1733007600, 0), tm)
+
+	// decimals are not truncated into ints
+	var n int
+	errs = z.Int().Parse(Decode(strings.NewReader(`1.5`)), &n)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeCoerce, errs[0].Code)
+}
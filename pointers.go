@@ -27,6 +27,19 @@ func (v *PointerSchema) setCoercer(c conf.CoercerFunc) {
 	v.schema.setCoercer(c)
 }
 
+// Returns a deep copy of the schema. Used to clone inner schemas of complex schemas
+func (v *PointerSchema) cloneSchema() ZogSchema {
+	return v.Clone()
+}
+
+// Returns the description of the schema. See z.Describe
+func (v *PointerSchema) describe(d *describer) *SchemaDescription {
+	desc := d.new(v, zconst.TypePtr)
+	desc.Required = v.required != nil
+	desc.Schema = d.schema(v.schema)
+	return desc
+}
+
 // Ptr creates a pointer ZogSchema
 func Ptr(schema ZogSchema) *PointerSchema {
 	return &PointerSchema{
@@ -58,13 +71,17 @@ func (v *PointerSchema) process(ctx *p.SchemaCtx) {
 	// Companion code to this codde is in struct.go > process
 	subCtx := ctx.NewSchemaCtx(ctx.Data, ctx.ValPtr, ctx.Path, v.schema.getType())
 	defer subCtx.Free()
+	// so a z.When behind the pointer can read its siblings
+	subCtx.Parent = ctx.Parent
 	if fn, ok := ctx.Data.(p.DpFactory); ok {
 		val, err := fn()
 		if err != nil {
 			ctx.AddIssue(subCtx.IssueFromUnknownError(err))
 			return
 		}
+		// the factory consumes the input (i.e the request body) so the inner schema gets the data provider
 		ctx.Data = val
+		subCtx.Data = val
 	}
 	_, isEmptyStruct := ctx.Data.(*p.EmptyDataProvider)
 	// End of messy code
@@ -118,7 +135,23 @@ func (v *PointerSchema) validate(ctx *p.SchemaCtx) {
 	}
 	di := destPtr.Interface()
 	ctx.ValPtr = di
-	v.schema.validate(ctx.NewValidateSchemaCtx(di, ctx.Path, v.schema.getType()))
+	subCtx := ctx.NewValidateSchemaCtx(di, ctx.Path, v.schema.getType())
+	defer subCtx.Free()
+	subCtx.Parent = ctx.Parent
+	v.schema.validate(subCtx)
+}
+
+// Returns a deep copy of the schema and the schema it points to
+func (v *PointerSchema) Clone() *PointerSchema {
+	return &PointerSchema{
+		schema:   v.schema.cloneSchema(),
+		required: v.required.Clone(),
+	}
+}
+
+// Returns a walkable description of the schema. See z.Describe
+func (v *PointerSchema) Describe() *SchemaDescription {
+	return Describe(v)
 }
 
 // Validate Existing Pointer
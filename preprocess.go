@@ -62,6 +62,26 @@ func (s *PreprocessSchema[F, T]) setCoercer(coercer CoercerFunc) {
 	s.schema.setCoercer(coercer)
 }
 
+// Returns a deep copy of the schema. Used to clone inner schemas of complex schemas
+func (s *PreprocessSchema[F, T]) cloneSchema() ZogSchema {
+	return s.Clone()
+}
+
+// Returns the description of the schema. See z.Describe
+func (s *PreprocessSchema[F, T]) describe(d *describer) *SchemaDescription {
+	return d.schema(s.schema)
+}
+
+// Returns a deep copy of the schema and the schema it wraps
+func (s *PreprocessSchema[F, T]) Clone() *PreprocessSchema[F, T] {
+	return &PreprocessSchema[F, T]{schema: s.schema.cloneSchema(), fn: s.fn}
+}
+
+// Returns a walkable description of the schema. See z.Describe
+func (s *PreprocessSchema[F, T]) Describe() *SchemaDescription {
+	return Describe(s)
+}
+
 func (s *PreprocessSchema[F, T]) Parse(data F, destPtr *T, options ...ExecOption) ZogIssueList {
 	errs := p.NewErrsList()
 	defer errs.Free()
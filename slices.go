@@ -17,9 +17,9 @@ type SliceSchema struct {
 	schema     ZogSchema
 	required   *p.Test[any]
 	defaultVal any
-	// catch          any
-	coercer conf.CoercerFunc
-	isNot   bool
+	catch      any
+	coercer    conf.CoercerFunc
+	isNot      bool
 }
 
 type NotSliceSchema interface {
@@ -37,6 +37,22 @@ func (v *SliceSchema) setCoercer(c conf.CoercerFunc) {
 	v.coercer = c
 }
 
+// Returns a deep copy of the schema. Used to clone inner schemas of complex schemas
+func (v *SliceSchema) cloneSchema() ZogSchema {
+	return v.Clone()
+}
+
+// Returns the description of the schema. See z.Describe
+func (v *SliceSchema) describe(d *describer) *SchemaDescription {
+	desc := d.new(v, v.getType())
+	desc.Required = v.required != nil
+	desc.Default = v.defaultVal
+	desc.Catch = v.catch
+	desc.Tests = describeTests(v.processors)
+	desc.Schema = d.schema(v.schema)
+	return desc
+}
+
 // ! USER FACING FUNCTIONS
 
 // Creates a slice schema. That is a Zog representation of a slice.
@@ -72,14 +88,21 @@ func (v *SliceSchema) Validate(data any, options ...ExecOption) ZogIssueList {
 
 // Internal function to validate the data
 func (v *SliceSchema) validate(ctx *p.SchemaCtx) {
+	if v.catch != nil {
+		complexCatch(ctx, v.catch, v.validateSlice)
+		return
+	}
+	v.validateSlice(ctx)
+}
 
+func (v *SliceSchema) validateSlice(ctx *p.SchemaCtx) {
 	refVal := reflect.ValueOf(ctx.ValPtr).Elem() // we use this to set the value to the ptr. But we still reference the ptr everywhere. This is correct even if it seems confusing.
 	// 2. cast data to string & handle default/required
 	isZeroVal := p.IsZeroValue(ctx.ValPtr)
 
 	if isZeroVal || refVal.Len() == 0 {
 		if v.defaultVal != nil {
-			refVal.Set(reflect.ValueOf(v.defaultVal))
+			refVal.Set(deepCopy(reflect.ValueOf(v.defaultVal)))
 		} else if v.required == nil {
 			return
 		} else {
@@ -90,16 +113,16 @@ func (v *SliceSchema) validate(ctx *p.SchemaCtx) {
 	}
 
 	// 3.1 tests for slice items
-	subCtx := ctx.NewValidateSchemaCtx(ctx.ValPtr, ctx.Path, v.schema.getType())
-	defer subCtx.Free()
-	for idx := 0; idx < refVal.Len(); idx++ {
+	completed := eachChild(ctx, refVal.Len(), v.schema.getType(), func(idx int, subCtx *p.SchemaCtx) {
 		item := refVal.Index(idx).Addr().Interface()
 		k := fmt.Sprintf("[%d]", idx)
 		subCtx.ValPtr = item
 		subCtx.Path.Push(&k)
-		subCtx.Exit = false
 		v.schema.validate(subCtx)
 		subCtx.Path.Pop()
+	})
+	if !completed {
+		return
 	}
 
 	for _, processor := range v.processors {
@@ -132,7 +155,18 @@ func (v *SliceSchema) Parse(data any, dest any, options ...ExecOption) ZogIssueL
 
 // Internal function to process the data
 func (v *SliceSchema) process(ctx *p.SchemaCtx) {
+	if v.catch != nil {
+		complexCatch(ctx, v.catch, v.processSlice)
+		return
+	}
+	v.processSlice(ctx)
+}
 
+func (v *SliceSchema) processSlice(ctx *p.SchemaCtx) {
+	// 1. unwrap data providers so json arrays can be parsed
+	if !resolveDataProvider(ctx) {
+		return
+	}
 	// 2. cast data to string & handle default/required
 	isZeroVal := p.IsParseZeroValue(ctx.Data, ctx)
 	var refVal reflect.Value
@@ -161,9 +195,7 @@ func (v *SliceSchema) process(ctx *p.SchemaCtx) {
 	destVal.Set(reflect.MakeSlice(destVal.Type(), refVal.Len(), refVal.Len()))
 
 	// 3.1 tests for slice items
-	subCtx := ctx.NewSchemaCtx(ctx.Data, ctx.ValPtr, ctx.Path, v.schema.getType())
-	defer subCtx.Free()
-	for idx := 0; idx < refVal.Len(); idx++ {
+	completed := eachChild(ctx, refVal.Len(), v.schema.getType(), func(idx int, subCtx *p.SchemaCtx) {
 		item := refVal.Index(idx).Interface()
 		ptr := destVal.Index(idx).Addr().Interface()
 		k := fmt.Sprintf("[%d]", idx)
@@ -172,6 +204,9 @@ func (v *SliceSchema) process(ctx *p.SchemaCtx) {
 		subCtx.Path.Push(&k)
 		v.schema.process(subCtx)
 		subCtx.Path.Pop()
+	})
+	if !completed {
+		return
 	}
 
 	for _, processor := range v.processors {
@@ -192,6 +227,24 @@ func (v *SliceSchema) Transform(transform Transform[any]) *SliceSchema {
 	return v
 }
 
+// Returns a deep copy of the schema. The item schema, tests, transforms & required are copied so changing the copy doesn't affect the original. Default & catch values are deep copied too
+func (v *SliceSchema) Clone() *SliceSchema {
+	return &SliceSchema{
+		processors: p.CloneProcessors(v.processors),
+		schema:     v.schema.cloneSchema(),
+		required:   v.required.Clone(),
+		defaultVal: cloneValue(v.defaultVal),
+		catch:      cloneValue(v.catch),
+		coercer:    v.coercer,
+		isNot:      v.isNot,
+	}
+}
+
+// Returns a walkable description of the schema. See z.Describe
+func (v *SliceSchema) Describe() *SchemaDescription {
+	return Describe(v)
+}
+
 // !MODIFIERS
 
 // marks field as required
@@ -210,18 +263,18 @@ func (v *SliceSchema) Optional() *SliceSchema {
 	return v
 }
 
-// sets the default value
+// sets the default value. The destination gets a deep copy so changing it doesn't change the default
 func (v *SliceSchema) Default(val any) *SliceSchema {
 	v.defaultVal = val
 	return v
 }
 
-// NOT IMPLEMENTED YET
-// sets the catch value (i.e the value to use if the validation fails)
-// func (v *SliceSchema) Catch(val string) *SliceSchema {
-// 	v.catch = &val
-// 	return v
-// }
+// sets the catch value (i.e the value to use if the validation fails). Must be a value of the destination slice type.
+// If any item or slice level test raises an issue the whole slice is replaced with a deep copy of the catch value and the issues are discarded
+func (v *SliceSchema) Catch(val any) *SliceSchema {
+	v.catch = val
+	return v
+}
 
 // !TESTS
 
@@ -230,3 +230,65 @@ func TestSliceGetType(t *testing.T) {
 	s := Slice(String())
 	assert.Equal(t, zconst.TypeSlice, s.getType())
 }
+
+func TestSliceCatch(t *testing.T) {
+	schema := Slice(Int().GT(0)).Min(1).Catch([]int{1})
+
+	var dest []int
+	errs := schema.Parse([]any{1, -1, 3}, &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, []int{1}, dest)
+
+	dest = nil
+	errs = schema.Parse([]any{}, &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, []int{1}, dest)
+
+	dest = nil
+	errs = schema.Parse([]any{"x"}, &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, []int{1}, dest)
+
+	dest = nil
+	errs = schema.Parse([]any{2, 3}, &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, []int{2, 3}, dest)
+}
+
+func TestSliceCatchIsCopied(t *testing.T) {
+	schema := Slice(Int().GT(0)).Catch([]int{1})
+
+	var dest []int
+	errs := schema.Parse([]any{-1}, &dest)
+	assert.Empty(t, errs)
+	dest[0] = 100
+
+	var dest2 []int
+	errs = schema.Parse([]any{-1}, &dest2)
+	assert.Empty(t, errs)
+	assert.Equal(t, []int{1}, dest2)
+}
+
+func TestSliceCatchRequired(t *testing.T) {
+	schema := Slice(String()).Required().Catch([]string{"default"})
+	var dest []string
+	errs := schema.Parse(nil, &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, []string{"default"}, dest)
+}
+
+func TestSliceCatchInStruct(t *testing.T) {
+	type Tags struct {
+		Name string
+		Tags []string
+	}
+	schema := Struct(Shape{
+		"name": String().Required(),
+		"tags": Slice(String().Min(2)).Catch([]string{}),
+	})
+	var dest Tags
+	errs := schema.Parse(map[string]any{"tags": []any{"ok", "x"}}, &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "name", errs[0].PathString())
+	assert.Equal(t, []string{}, dest.Tags)
+}
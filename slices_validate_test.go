@@ -51,6 +51,23 @@ func TestValidateSliceDefault(t *testing.T) {
 	assert.Equal(t, []string{"default"}, dest)
 }
 
+func TestValidateSliceDefaultIsCopied(t *testing.T) {
+	// the trim runs on the destination items so it must not reach the default
+	validator := Slice(String().Trim()).Default([]string{" default "})
+	var dest []string
+	errs := validator.Validate(&dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, []string{"default"}, dest)
+
+	var dest2 []string
+	errs = validator.Validate(&dest2)
+	assert.Empty(t, errs)
+	dest2[0] = "changed"
+	var dest3 []string
+	validator.Validate(&dest3)
+	assert.Equal(t, []string{"default"}, dest3)
+}
+
 func TestValidateSliceTransform(t *testing.T) {
 	transform := func(val any, ctx Ctx) error {
 		if v, ok := val.(*[]string); ok {
@@ -259,3 +276,17 @@ func TestValidateSliceNot(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateSliceCatch(t *testing.T) {
+	schema := Slice(Int().GT(0)).Catch([]int{1})
+
+	dest := []int{1, -1}
+	errs := schema.Validate(&dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, []int{1}, dest)
+
+	dest = []int{2, 3}
+	errs = schema.Validate(&dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, []int{2, 3}, dest)
+}
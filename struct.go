@@ -3,6 +3,11 @@ package zog
 import (
 	"fmt"
 	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
 
 	"github.com/Oudwins/zog/conf"
 	p "github.com/Oudwins/zog/internals"
@@ -12,13 +17,26 @@ import (
 var _ ComplexZogSchema = &StructSchema{}
 
 type StructSchema struct {
-	schema     Shape
-	processors []p.ZProcessor[any]
-	// defaultVal     any
-	required *p.Test[any]
-	// catch          any
+	schema           Shape
+	processors       []p.ZProcessor[any]
+	defaultVal       any
+	required         *p.Test[any]
+	catch            any
+	unknownKeys      unknownKeysMode
+	passthroughField string
+	// execution order of the shape keys for each destination type. reflect.Type -> []string. See fieldOrder
+	fieldOrders sync.Map
 }
 
+// what the struct schema does with input keys that are not in the shape
+type unknownKeysMode int
+
+const (
+	unknownKeysStrip unknownKeysMode = iota
+	unknownKeysStrict
+	unknownKeysPassthrough
+)
+
 // Returns the type of the schema
 func (v *StructSchema) getType() zconst.ZogType {
 	return zconst.TypeStruct
@@ -29,6 +47,25 @@ func (v *StructSchema) setCoercer(c conf.CoercerFunc) {
 	// noop
 }
 
+// Returns a deep copy of the schema. Used to clone inner schemas of complex schemas
+func (v *StructSchema) cloneSchema() ZogSchema {
+	return v.Clone()
+}
+
+// Returns the description of the schema. See z.Describe
+func (v *StructSchema) describe(d *describer) *SchemaDescription {
+	desc := d.new(v, v.getType())
+	desc.Required = v.required != nil
+	desc.Default = v.defaultVal
+	desc.Catch = v.catch
+	desc.Tests = describeTests(v.processors)
+	desc.Shape = make(map[string]*SchemaDescription, len(v.schema))
+	for k, s := range v.schema {
+		desc.Shape[k] = d.schema(s)
+	}
+	return desc
+}
+
 // ! USER FACING FUNCTIONS
 
 // A map of field names to zog schemas
@@ -64,10 +101,24 @@ func (v *StructSchema) Parse(data any, destPtr any, options ...ExecOption) ZogIs
 }
 
 func (v *StructSchema) process(ctx *p.SchemaCtx) {
+	if v.catch != nil {
+		complexCatch(ctx, v.catch, v.processStruct)
+		return
+	}
+	v.processStruct(ctx)
+}
+
+func (v *StructSchema) processStruct(ctx *p.SchemaCtx) {
+	// 1. handle default. The default value is used as is, only the struct level tests & transforms run on it
+	if v.defaultVal != nil && p.IsParseZeroValue(ctx.Data, ctx) {
+		setValue(ctx.ValPtr, v.defaultVal)
+		v.runProcessors(ctx)
+		return
+	}
 
-	var dataProv p.DataProvider
 	// 2. cast data as DataProvider
-	if factory, ok := ctx.Data.(p.DpFactory); ok {
+	data := ctx.Data
+	if factory, ok := data.(p.DpFactory); ok {
 		newDp, err := factory()
 		// This is a little bit hacky. But we want to exit here because the error came from zhttp. Meaning we had an error trying to parse the request.
 		// I'm not sure if this is the best behaviour? Do we want to exit here or do we want to continue processing (ofc we add the error always)
@@ -75,14 +126,13 @@ func (v *StructSchema) process(ctx *p.SchemaCtx) {
 			ctx.AddIssue(ctx.IssueFromUnknownError(err))
 			return
 		}
-		dataProv = newDp
-	} else {
-		newDp, err := p.TryNewAnyDataProvider(ctx.Data)
-		if err != nil {
-			ctx.AddIssue(ctx.IssueFromCoerce(err))
-			return
-		}
-		dataProv = newDp
+		data = newDp
+	}
+	// values that are not objects (i.e json arrays) fail to convert
+	dataProv, err := p.TryNewAnyDataProvider(data)
+	if err != nil {
+		ctx.AddIssue(ctx.IssueFromCoerce(err))
+		return
 	}
 
 	// 3. Process / validate struct fields
@@ -92,9 +142,12 @@ func (v *StructSchema) process(ctx *p.SchemaCtx) {
 		p.Panicf(p.PanicInvalidArgumentsExpectedPointer)
 	}
 	structVal := structRefVal.Elem()
-	subCtx := ctx.NewSchemaCtx(ctx.Data, ctx.ValPtr, ctx.Path, v.getType())
-	defer subCtx.Free()
-	for key, processor := range v.schema {
+	keys := v.fieldOrder(structVal.Type())
+	// input keys used by each field. Collected per field since fields may be processed concurrently
+	fieldKeys := make([]string, len(keys))
+	completed := v.eachField(ctx, keys, func(i int, subCtx *p.SchemaCtx) {
+		key := keys[i]
+		processor := v.schema[key]
 		originalKey := key
 		if key[0] >= 'a' && key[0] <= 'z' {
 			var b [32]byte // Use a size that fits your max key length
@@ -110,24 +163,136 @@ func (v *StructSchema) process(ctx *p.SchemaCtx) {
 		destPtr := structVal.FieldByName(key).Addr().Interface()
 
 		subValue, fieldKey := dataProv.GetByField(fieldMeta, originalKey)
+		fieldKeys[i] = fieldKey
 		subCtx.Data = subValue
 		subCtx.ValPtr = destPtr
+		subCtx.Parent = structVal
+		// fields read from several sources (i.e zhttp.All) are pathed by source first
+		sourceProv, hasSource := dataProv.(p.SourceDataProvider)
+		if hasSource {
+			source := sourceProv.FieldSource(fieldMeta)
+			subCtx.Path.Push(&source)
+		}
 		subCtx.Path.Push(&fieldKey)
 		subCtx.DType = processor.getType()
-		subCtx.Exit = false
 		processor.process(subCtx)
 		subCtx.Path.Pop()
+		if hasSource {
+			subCtx.Path.Pop()
+		}
+	})
+	if !completed {
+		return
+	}
+
+	// 4. unknown keys
+	if v.unknownKeys != unknownKeysStrip {
+		knownKeys := make(map[string]bool, len(fieldKeys))
+		for _, k := range fieldKeys {
+			knownKeys[k] = true
+		}
+		v.processUnknownKeys(ctx, dataProv, structVal, knownKeys)
+	}
+
+	v.runProcessors(ctx)
+}
+
+// returns the shape keys in the order the fields are executed: the order the fields are declared in the destination struct.
+// Conditional fields (z.When) go last since they read the values of their siblings. With z.WithParallelism only conditional fields are guaranteed to run after the other fields, the rest run concurrently. The order is computed once per destination type
+func (v *StructSchema) fieldOrder(typ reflect.Type) []string {
+	if keys, ok := v.fieldOrders.Load(typ); ok {
+		return keys.([]string)
+	}
+	keys := make([]string, 0, len(v.schema))
+	index := make(map[string][]int, len(v.schema))
+	for k := range v.schema {
+		keys = append(keys, k)
+		// fields missing from the struct panic during execution. They are sorted by key after the rest
+		if field, ok := typ.FieldByName(strings.ToUpper(k[:1]) + k[1:]); ok {
+			index[k] = field.Index
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if ca, cb := isConditional(v.schema[a]), isConditional(v.schema[b]); ca != cb {
+			return cb
+		}
+		ia, oka := index[a]
+		ib, okb := index[b]
+		if oka != okb {
+			return oka
+		}
+		if c := slices.Compare(ia, ib); c != 0 {
+			return c < 0
+		}
+		return a < b
+	})
+	v.fieldOrders.Store(typ, keys)
+	return keys
+}
+
+// Runs fn for each of the fields in keys (see fieldOrder). Conditional fields only start once every other field is done. Returns false if the execution was stopped
+func (v *StructSchema) eachField(ctx *p.SchemaCtx, keys []string, fn func(i int, subCtx *p.SchemaCtx)) bool {
+	split := 0
+	for split < len(keys) && !isConditional(v.schema[keys[split]]) {
+		split++
+	}
+	run := func(from, to int) bool {
+		return eachChild(ctx, to-from, v.getType(), func(i int, subCtx *p.SchemaCtx) {
+			fn(from+i, subCtx)
+		})
+	}
+	return run(0, split) && run(split, len(keys))
+}
+
+// reports (strict) or collects (passthrough) the input keys that are not in the shape. Only works with data providers that can list their keys
+func (v *StructSchema) processUnknownKeys(ctx *p.SchemaCtx, dataProv p.DataProvider, structVal reflect.Value, knownKeys map[string]bool) {
+	var field reflect.Value
+	if v.unknownKeys == unknownKeysPassthrough {
+		field = structVal.FieldByName(v.passthroughField)
+		if !field.IsValid() || field.Type() != reflect.TypeOf(map[string]any{}) {
+			p.Panicf(p.PanicInvalidPassthroughField, ctx.String(), v.passthroughField)
+		}
+	}
+
+	keysProv, ok := dataProv.(p.KeysDataProvider)
+	if !ok {
+		return
+	}
+	var unknown []string
+	for _, k := range keysProv.Keys() {
+		if !knownKeys[k] {
+			unknown = append(unknown, k)
+		}
+	}
+	if len(unknown) == 0 {
+		return
+	}
+	sort.Strings(unknown)
+
+	switch v.unknownKeys {
+	case unknownKeysStrict:
+		ctx.AddIssue(ctx.Issue().SetCode(zconst.IssueCodeUnrecognizedKeys).SetParams(map[string]any{
+			zconst.IssueCodeUnrecognizedKeys: unknown,
+		}))
+	case unknownKeysPassthrough:
+		extra := make(map[string]any, len(unknown))
+		for _, k := range unknown {
+			extra[k] = dataProv.Get(k)
+		}
+		field.Set(reflect.ValueOf(extra))
 	}
+}
 
+// runs the struct level tests & transforms
+func (v *StructSchema) runProcessors(ctx *p.SchemaCtx) {
 	for _, processor := range v.processors {
 		ctx.Processor = processor
 		processor.ZProcess(ctx.ValPtr, ctx)
 		if ctx.Exit {
-			// Catch here
 			return
 		}
 	}
-
 }
 
 // Validate a struct pointer given the struct schema. Usage:
@@ -151,14 +316,26 @@ func (v *StructSchema) Validate(dataPtr any, options ...ExecOption) ZogIssueList
 
 // Internal function to validate the data
 func (v *StructSchema) validate(ctx *p.SchemaCtx) {
+	if v.catch != nil {
+		complexCatch(ctx, v.catch, v.validateStruct)
+		return
+	}
+	v.validateStruct(ctx)
+}
+
+func (v *StructSchema) validateStruct(ctx *p.SchemaCtx) {
 	refVal := reflect.ValueOf(ctx.ValPtr).Elem()
 
-	// 2. cast data to string & handle default/required
+	// 2. handle default. Unlike Parse, the default value is then validated like any other value
+	if v.defaultVal != nil && p.IsZeroValue(refVal.Interface()) {
+		setValue(ctx.ValPtr, v.defaultVal)
+	}
 
 	// 3.1 tests for struct fields
-	subCtx := ctx.NewSchemaCtx(ctx.Data, ctx.ValPtr, ctx.Path, v.getType())
-	defer subCtx.Free()
-	for key, schema := range v.schema {
+	keys := v.fieldOrder(refVal.Type())
+	completed := v.eachField(ctx, keys, func(i int, subCtx *p.SchemaCtx) {
+		key := keys[i]
+		schema := v.schema[key]
 		fieldKey := key
 		if key[0] >= 'a' && key[0] <= 'z' {
 			var b [32]byte // Use a size that fits your max key length
@@ -179,20 +356,17 @@ func (v *StructSchema) validate(ctx *p.SchemaCtx) {
 		}
 		subCtx.Data = destPtr
 		subCtx.ValPtr = destPtr
+		subCtx.Parent = refVal
 		subCtx.Path.Push(&fieldKey)
 		subCtx.DType = schema.getType()
 		schema.validate(subCtx)
 		subCtx.Path.Pop()
+	})
+	if !completed {
+		return
 	}
 
-	for _, processor := range v.processors {
-		ctx.Processor = processor
-		processor.ZProcess(ctx.ValPtr, ctx)
-		if ctx.Exit {
-			// Catch here
-			return
-		}
-	}
+	v.runProcessors(ctx)
 }
 
 // Adds posttransform function to schema
@@ -201,6 +375,33 @@ func (v *StructSchema) Transform(transform p.Transform[any]) *StructSchema {
 	return v
 }
 
+// Returns a deep copy of the schema. Every field schema, test, transform & required are copied so changing the copy doesn't affect the original. Default & catch values are deep copied too
+func (v *StructSchema) Clone() *StructSchema {
+	shape := make(Shape, len(v.schema))
+	for k, s := range v.schema {
+		shape[k] = s.cloneSchema()
+	}
+	return v.cloneWithShape(shape)
+}
+
+// Returns a walkable description of the schema. See z.Describe
+func (v *StructSchema) Describe() *SchemaDescription {
+	return Describe(v)
+}
+
+// copies everything except the shape which is replaced by the provided one
+func (v *StructSchema) cloneWithShape(shape Shape) *StructSchema {
+	return &StructSchema{
+		schema:           shape,
+		processors:       p.CloneProcessors(v.processors),
+		defaultVal:       cloneValue(v.defaultVal),
+		required:         v.required.Clone(),
+		catch:            cloneValue(v.catch),
+		unknownKeys:      v.unknownKeys,
+		passthroughField: v.passthroughField,
+	}
+}
+
 // ! MODIFIERS
 
 // Deprecated: structs are not required or optional. They pass through to the fields. If you want to say that an entire struct may not exist you should use z.Ptr(z.Struct(...))
@@ -216,17 +417,58 @@ func (v *StructSchema) Optional() *StructSchema {
 	return v
 }
 
-// // sets the default value
-// func (v *StructSchema) Default(val any) *StructSchema {
-// 	v.defaultVal = val
-// 	return v
-// }
+// sets the default value. Must be a value of the destination struct type (i.e User{} not &User{}).
+// Used when the input data is nil on Parse, or when the struct is the zero value on Validate. The destination gets a deep copy so changing it doesn't change the default
+func (v *StructSchema) Default(val any) *StructSchema {
+	v.defaultVal = val
+	return v
+}
+
+// sets the catch value (i.e the value to use if the validation fails). Must be a value of the destination struct type.
+// If any field or struct level test raises an issue the whole struct is replaced with a deep copy of the catch value and the issues are discarded
+func (v *StructSchema) Catch(val any) *StructSchema {
+	v.catch = val
+	return v
+}
+
+// Reports input keys that are not in the shape with a single unrecognized_keys issue at the struct path. The unknown keys are in the issue params.
+// Only applies to schema.Parse() and data providers that can list their keys: maps, zjson & zhttp.Request.
+// Unknown keys are ignored for zhttp.Path, zhttp.Headers, zhttp.Cookies & zhttp.All. Requests always carry headers & cookies the schema doesn't know about & the path wildcards can't be listed
+func (v *StructSchema) Strict() *StructSchema {
+	v.unknownKeys = unknownKeysStrict
+	return v
+}
+
+// Ignores input keys that are not in the shape. This is the default behaviour
+func (v *StructSchema) Strip() *StructSchema {
+	v.unknownKeys = unknownKeysStrip
+	v.passthroughField = ""
+	return v
+}
 
-// // sets the catch value (i.e the value to use if the validation fails)
-// func (v *StructSchema) Catch(val any) *StructSchema {
-// 	v.catch = val
-// 	return v
-// }
+// Collects the input keys that are not in the shape (and their raw values) into the destination struct field with that name. The field must be of type map[string]any. Usage:
+//
+//	type User struct {
+//		Name  string
+//		Extra map[string]any
+//	}
+//	z.Struct(z.Shape{"name": z.String()}).Passthrough("Extra")
+//
+// Only applies to schema.Parse() and data providers that can list their keys (see Strict).
+// Panics if field is not an exported Go identifier or if it is the field of a shape key. The destination is checked when parsing since it is not known before
+func (v *StructSchema) Passthrough(field string) *StructSchema {
+	if !isExportedIdentifier(field) {
+		p.Panicf(p.PanicPassthroughDefinition, field, "The field must be an exported Go identifier (i.e Extra)")
+	}
+	for k := range v.schema {
+		if strings.ToUpper(k[:1])+k[1:] == field {
+			p.Panicf(p.PanicPassthroughDefinition, field, fmt.Sprintf("The field is already used by the shape key %s", k))
+		}
+	}
+	v.unknownKeys = unknownKeysPassthrough
+	v.passthroughField = field
+	return v
+}
 
 // ! VALIDATORS
 // custom test function call it -> schema.Test(t z.Test)
@@ -242,3 +484,16 @@ func (v *StructSchema) TestFunc(testFunc BoolTFunc[any], options ...TestOption)
 	v.Test(Test[any](*test))
 	return v
 }
+
+// whether s is a valid exported Go identifier. Used to check field names given to the schema
+func isExportedIdentifier(s string) bool {
+	for i, r := range s {
+		if i == 0 && !unicode.IsUpper(r) {
+			return false
+		}
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' {
+			return false
+		}
+	}
+	return s != ""
+}
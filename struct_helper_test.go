@@ -319,3 +319,55 @@ func TestStructExtend(t *testing.T) {
 	assert.Equal(t, o.Name, "world")
 	assert.Equal(t, o.Age, 20)
 }
+
+func TestStructHelpersDoNotAliasSchemas(t *testing.T) {
+	type User struct {
+		Name string
+		Age  int
+	}
+	base := Struct(Shape{
+		"name": String(),
+		"age":  Int(),
+	})
+	picked := base.Pick("name")
+	omitted := base.Omit("age")
+	extended := base.Extend(Shape{"age": Int().GT(0)})
+	merged := base.Merge(Struct(Shape{"age": Int()}))
+
+	picked.schema["name"].(*StringSchema[string]).Required()
+	omitted.schema["name"].(*StringSchema[string]).Min(10)
+	extended.schema["name"].(*StringSchema[string]).Max(1)
+	merged.schema["name"].(*StringSchema[string]).Len(2)
+
+	var u User
+	errs := base.Parse(map[string]any{"name": "hello"}, &u)
+	assert.Empty(t, errs)
+	errs = base.Parse(map[string]any{}, &u)
+	assert.Empty(t, errs)
+
+	_, ok := picked.schema["age"]
+	assert.False(t, ok)
+}
+
+func TestStructMergeKeepsDefaultAndCatch(t *testing.T) {
+	type User struct {
+		Name string
+		Tags []string
+	}
+	base := Struct(Shape{"name": String()}).
+		Default(User{Name: "default", Tags: []string{"a"}}).
+		Catch(User{Name: "caught"})
+	merged := base.Merge(Struct(Shape{"tags": Slice(String().Min(3))}))
+
+	var u User
+	errs := merged.Parse(nil, &u)
+	assert.Empty(t, errs)
+	assert.Equal(t, User{Name: "default", Tags: []string{"a"}}, u)
+
+	errs = merged.Parse(map[string]any{"name": "bob", "tags": []string{"x"}}, &u)
+	assert.Empty(t, errs)
+	assert.Equal(t, User{Name: "caught"}, u)
+
+	merged.defaultVal.(User).Tags[0] = "changed"
+	assert.Equal(t, User{Name: "default", Tags: []string{"a"}}, base.defaultVal)
+}
@@ -1,15 +1,13 @@
 package zog
 
 import (
-	"maps"
-
 	p "github.com/Oudwins/zog/internals"
 )
 
 // Merge combines two or more schemas into a new schema.
-// It performs a shallow merge, meaning:
+// All field schemas, transforms and tests are cloned & the default and catch values of the receiver are deep copied, meaning:
 //   - Fields with the same key from later schemas override earlier ones
-//   - Modifying nested schemas may affect the original schemas
+//   - Modifying the new schema (or its nested schemas) does not affect the original schemas
 //
 // Parameters:
 //   - other: The first schema to merge with
@@ -22,61 +20,58 @@ func (v *StructSchema) Merge(other *StructSchema, others ...*StructSchema) *Stru
 		totalProcessors += len(o.processors)
 	}
 	new := &StructSchema{
-		processors: make([]p.ZProcessor[any], 0, totalProcessors),
-		required:   other.required,
-		schema:     Shape{},
+		processors:       make([]p.ZProcessor[any], 0, totalProcessors),
+		required:         other.required.Clone(),
+		defaultVal:       cloneValue(v.defaultVal),
+		catch:            cloneValue(v.catch),
+		schema:           Shape{},
+		unknownKeys:      other.unknownKeys,
+		passthroughField: other.passthroughField,
 	}
 
 	// processors
-	new.processors = append(new.processors, v.processors...)
-	new.processors = append(new.processors, other.processors...)
+	new.processors = append(new.processors, p.CloneProcessors(v.processors)...)
+	new.processors = append(new.processors, p.CloneProcessors(other.processors)...)
 	for _, s := range others {
-		new.processors = append(new.processors, s.processors...)
+		new.processors = append(new.processors, p.CloneProcessors(s.processors)...)
 	}
 
-	maps.Copy(new.schema, v.schema)
-	maps.Copy(new.schema, other.schema)
+	copyShape(new.schema, v.schema)
+	copyShape(new.schema, other.schema)
 	for _, s := range others {
-		maps.Copy(new.schema, s.schema)
+		copyShape(new.schema, s.schema)
 	}
 
 	return new
 }
 
-// cloneShallow creates a shallow copy of the schema.
-// The new schema shares references to the transforms, tests and inner schema.
-func (v *StructSchema) cloneShallow() *StructSchema {
-	new := &StructSchema{
-		processors: v.processors,
-		required:   v.required,
-		schema:     v.schema,
-	}
-	return new
-}
-
 // Omit creates a new schema with specified fields removed.
 // It accepts either strings or map[string]bool as arguments:
 //   - Strings directly specify fields to omit
 //   - For maps, fields are omitted when their boolean value is true
 //
-// Returns a new schema with the specified fields removed
+// Returns a new schema with the specified fields removed. The remaining fields are cloned
 func (v *StructSchema) Omit(vals ...any) *StructSchema {
-	new := v.cloneShallow()
-	new.schema = Shape{}
-	maps.Copy(new.schema, v.schema)
+	omit := map[string]bool{}
 	for _, k := range vals {
 		switch k := k.(type) {
 		case string:
-			delete(new.schema, k)
+			omit[k] = true
 		case map[string]bool:
 			for key, val := range k {
 				if val {
-					delete(new.schema, key)
+					omit[key] = true
 				}
 			}
 		}
 	}
-	return new
+	shape := Shape{}
+	for k, s := range v.schema {
+		if !omit[k] {
+			shape[k] = s.cloneSchema()
+		}
+	}
+	return v.cloneWithShape(shape)
 }
 
 // Pick creates a new schema keeping only the specified fields.
@@ -84,23 +79,27 @@ func (v *StructSchema) Omit(vals ...any) *StructSchema {
 //   - Strings directly specify fields to keep
 //   - For maps, fields are kept when their boolean value is true
 //
-// Returns a new schema containing only the specified fields
+// Returns a new schema containing only the specified fields. The picked fields are cloned
 func (v *StructSchema) Pick(picks ...any) *StructSchema {
-	new := v.cloneShallow()
-	new.schema = Shape{}
+	shape := Shape{}
+	pickKey := func(k string) {
+		if s, ok := v.schema[k]; ok {
+			shape[k] = s.cloneSchema()
+		}
+	}
 	for _, pick := range picks {
 		switch pick := pick.(type) {
 		case string:
-			new.schema[pick] = v.schema[pick]
+			pickKey(pick)
 		case map[string]bool:
 			for k, pick := range pick {
 				if pick {
-					new.schema[k] = v.schema[k]
+					pickKey(k)
 				}
 			}
 		}
 	}
-	return new
+	return v.cloneWithShape(shape)
 }
 
 // Extend creates a new schema by adding additional fields from the provided schema.
@@ -109,11 +108,17 @@ func (v *StructSchema) Pick(picks ...any) *StructSchema {
 // Parameters:
 //   - schema: The schema containing fields to add
 //
-// Returns a new schema with the additional fields
+// Returns a new schema with the additional fields. All fields are cloned
 func (v *StructSchema) Extend(schema Shape) *StructSchema {
-	new := v.cloneShallow()
-	new.schema = Shape{}
-	maps.Copy(new.schema, v.schema)
-	maps.Copy(new.schema, schema)
-	return new
+	shape := Shape{}
+	copyShape(shape, v.schema)
+	copyShape(shape, schema)
+	return v.cloneWithShape(shape)
+}
+
+// copies a clone of every field schema in src into dst
+func copyShape(dst Shape, src Shape) {
+	for k, s := range src {
+		dst[k] = s.cloneSchema()
+	}
 }
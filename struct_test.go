@@ -386,3 +386,272 @@ func TestStructGetType(t *testing.T) {
 	})
 	assert.Equal(t, zconst.TypeStruct, s.getType())
 }
+
+func TestStructDefault(t *testing.T) {
+	type Settings struct {
+		Theme string
+		Size  int
+	}
+	schema := Struct(Shape{
+		"theme": String().Required(),
+		"size":  Int(),
+	}).Default(Settings{Theme: "dark", Size: 12})
+
+	var dest Settings
+	errs := schema.Parse(nil, &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, Settings{Theme: "dark", Size: 12}, dest)
+
+	dest = Settings{}
+	errs = schema.Parse(map[string]any{"theme": "light"}, &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, Settings{Theme: "light"}, dest)
+}
+
+func TestStructDefaultNested(t *testing.T) {
+	type Settings struct {
+		Theme string
+	}
+	type User struct {
+		Settings Settings
+	}
+	schema := Struct(Shape{
+		"settings": Struct(Shape{"theme": String().Required()}).Default(Settings{Theme: "dark"}),
+	})
+	var dest User
+	errs := schema.Parse(map[string]any{}, &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, "dark", dest.Settings.Theme)
+}
+
+func TestStructCatch(t *testing.T) {
+	type Settings struct {
+		Theme string
+		Size  int
+	}
+	fallback := Settings{Theme: "dark", Size: 12}
+	schema := Struct(Shape{
+		"theme": String().Required().OneOf([]string{"dark", "light"}),
+		"size":  Int().GT(0),
+	}).Catch(fallback)
+
+	var dest Settings
+	errs := schema.Parse(map[string]any{"theme": "blue", "size": 10}, &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, fallback, dest)
+
+	dest = Settings{}
+	errs = schema.Parse("not a struct", &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, fallback, dest)
+
+	dest = Settings{}
+	errs = schema.Parse(map[string]any{"theme": "light", "size": 10}, &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, Settings{Theme: "light", Size: 10}, dest)
+}
+
+func TestStructDefaultAndCatchAreCopied(t *testing.T) {
+	type Settings struct {
+		Tags  []string
+		Flags map[string]bool
+	}
+	shape := Shape{
+		"tags":  Slice(String().Min(2)),
+		"flags": Map(String(), Bool()),
+	}
+	schema := Struct(shape).Default(Settings{Tags: []string{"default"}, Flags: map[string]bool{"a": true}})
+	var dest Settings
+	errs := schema.Parse(nil, &dest)
+	assert.Empty(t, errs)
+	dest.Tags[0] = "changed"
+	dest.Flags["a"] = false
+
+	dest = Settings{}
+	errs = schema.Parse(nil, &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, Settings{Tags: []string{"default"}, Flags: map[string]bool{"a": true}}, dest)
+
+	schema = Struct(shape).Catch(Settings{Tags: []string{"catch"}, Flags: map[string]bool{"a": true}})
+	dest = Settings{}
+	errs = schema.Parse(map[string]any{"tags": []any{"x"}}, &dest)
+	assert.Empty(t, errs)
+	dest.Tags[0] = "changed"
+	dest.Flags["a"] = false
+
+	dest = Settings{}
+	errs = schema.Parse(map[string]any{"tags": []any{"x"}}, &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, Settings{Tags: []string{"catch"}, Flags: map[string]bool{"a": true}}, dest)
+}
+
+func TestStructCatchStructTest(t *testing.T) {
+	type Range struct {
+		From int
+		To   int
+	}
+	schema := Struct(Shape{
+		"from": Int(),
+		"to":   Int(),
+	}).TestFunc(func(val any, ctx Ctx) bool {
+		r := val.(*Range)
+		return r.From <= r.To
+	}).Catch(Range{From: 0, To: 10})
+
+	var dest Range
+	errs := schema.Parse(map[string]any{"from": 5, "to": 1}, &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, Range{From: 0, To: 10}, dest)
+}
+
+func TestStructCatchNested(t *testing.T) {
+	type Settings struct {
+		Theme string
+	}
+	type User struct {
+		Name     string
+		Settings Settings
+	}
+	schema := Struct(Shape{
+		"name":     String().Required(),
+		"settings": Struct(Shape{"theme": String().Min(3)}).Catch(Settings{Theme: "dark"}),
+	})
+	var dest User
+	errs := schema.Parse(map[string]any{"settings": map[string]any{"theme": "x"}}, &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "name", errs[0].PathString())
+	assert.Equal(t, "dark", dest.Settings.Theme)
+}
+
+func TestStructStrictUnknownKeys(t *testing.T) {
+	type User struct {
+		Name  string
+		Email string `zog:"email_address"`
+	}
+	schema := Struct(Shape{
+		"name":  String(),
+		"email": String(),
+	}).Strict()
+
+	var u User
+	errs := schema.Parse(map[string]any{"name": "zog", "email_address": "a@b.com", "emial": "x", "age": 1}, &u)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeUnrecognizedKeys, errs[0].Code)
+	assert.Nil(t, errs[0].Path)
+	assert.Equal(t, []string{"age", "emial"}, errs[0].Params[zconst.IssueCodeUnrecognizedKeys])
+	assert.Equal(t, "unrecognized keys [age emial]", errs[0].Message)
+	assert.Equal(t, "zog", u.Name)
+	assert.Equal(t, "a@b.com", u.Email)
+	tutils.VerifyDefaultIssueMessages(t, errs)
+
+	errs = schema.Parse(map[string]any{"name": "zog"}, &u)
+	assert.Empty(t, errs)
+}
+
+func TestStructStrictNested(t *testing.T) {
+	type Address struct {
+		City string
+	}
+	type User struct {
+		Address Address
+	}
+	schema := Struct(Shape{
+		"address": Struct(Shape{"city": String()}).Strict(),
+	})
+
+	var u User
+	errs := schema.Parse(map[string]any{"extra": 1, "address": map[string]any{"city": "Madrid", "zip": "28001"}}, &u)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, []string{"address"}, errs[0].Path)
+	assert.Equal(t, []string{"zip"}, errs[0].Params[zconst.IssueCodeUnrecognizedKeys])
+}
+
+func TestStructStripIsDefault(t *testing.T) {
+	type User struct {
+		Name string
+	}
+	var u User
+	errs := Struct(Shape{"name": String()}).Strict().Strip().Parse(map[string]any{"name": "zog", "other": 1}, &u)
+	assert.Empty(t, errs)
+	assert.Equal(t, "zog", u.Name)
+}
+
+func TestStructPassthrough(t *testing.T) {
+	type User struct {
+		Name  string
+		Extra map[string]any
+	}
+	schema := Struct(Shape{"name": String()}).Passthrough("Extra")
+
+	var u User
+	errs := schema.Parse(map[string]any{"name": "zog", "age": 10, "role": "admin"}, &u)
+	assert.Empty(t, errs)
+	assert.Equal(t, "zog", u.Name)
+	assert.Equal(t, map[string]any{"age": 10, "role": "admin"}, u.Extra)
+
+	type NoExtra struct {
+		Name string
+	}
+	assert.Panics(t, func() {
+		schema.Parse(map[string]any{"name": "zog"}, &NoExtra{})
+	})
+}
+
+func TestStructPassthroughDefinition(t *testing.T) {
+	assert.Panics(t, func() { Struct(Shape{"name": String()}).Passthrough("extra") })
+	assert.Panics(t, func() { Struct(Shape{"name": String()}).Passthrough("") })
+	assert.Panics(t, func() { Struct(Shape{"name": String()}).Passthrough("Extra-Keys") })
+	// the field would hold both the name & the unknown keys
+	assert.Panics(t, func() { Struct(Shape{"name": String()}).Passthrough("Name") })
+	assert.NotPanics(t, func() { Struct(Shape{"name": String()}).Passthrough("Extra_2") })
+}
+
+func TestStructFieldOrder(t *testing.T) {
+	type Base struct {
+		ID string
+	}
+	type User struct {
+		Base
+		Zeta  string
+		Alpha string
+		Mid   string
+		Check string
+	}
+	var order []string
+	track := func(key string) *StringSchema[string] {
+		return String().Required().Transform(func(val *string, ctx Ctx) error {
+			order = append(order, key)
+			return nil
+		})
+	}
+	schema := Struct(Shape{
+		"mid":   track("mid"),
+		"alpha": track("alpha"),
+		"check": When("alpha", func(alpha string) bool {
+			// siblings are parsed before conditional fields
+			return alpha == "a"
+		}, String().Required(), nil),
+		"zeta": track("zeta"),
+		"iD":   track("iD"),
+	})
+
+	for i := 0; i < 20; i++ {
+		order = nil
+		var dest User
+		errs := schema.Parse(map[string]any{"alpha": "a"}, &dest)
+		paths := make([]string, len(errs))
+		for i, e := range errs {
+			paths[i] = e.PathString()
+		}
+		assert.Equal(t, []string{"iD", "zeta", "mid", "check"}, paths)
+		assert.Equal(t, []string{"alpha"}, order)
+
+		dest = User{Alpha: "a"}
+		errs = schema.Validate(&dest)
+		paths = paths[:0]
+		for _, e := range errs {
+			paths = append(paths, e.PathString())
+		}
+		assert.Equal(t, []string{"iD", "zeta", "mid", "check"}, paths)
+	}
+}
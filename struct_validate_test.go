@@ -233,3 +233,44 @@ func TestValidateStructInvalidSchema(t *testing.T) {
 		schema.Validate(&dest)
 	})
 }
+
+func TestValidateStructDefault(t *testing.T) {
+	type Settings struct {
+		Theme string
+		Size  int
+	}
+	schema := Struct(Shape{
+		"theme": String().Required().Min(5),
+		"size":  Int(),
+	}).Default(Settings{Theme: "dark", Size: 12})
+
+	var dest Settings
+	errs := schema.Validate(&dest)
+	// the default value is validated like any other value
+	assert.Len(t, errs, 1)
+	assert.Equal(t, Settings{Theme: "dark", Size: 12}, dest)
+
+	dest = Settings{Theme: "light"}
+	errs = schema.Validate(&dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, Settings{Theme: "light"}, dest)
+}
+
+func TestValidateStructCatch(t *testing.T) {
+	type Settings struct {
+		Theme string
+	}
+	schema := Struct(Shape{
+		"theme": String().Required().OneOf([]string{"dark", "light"}),
+	}).Catch(Settings{Theme: "dark"})
+
+	dest := Settings{Theme: "blue"}
+	errs := schema.Validate(&dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, Settings{Theme: "dark"}, dest)
+
+	dest = Settings{Theme: "light"}
+	errs = schema.Validate(&dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, Settings{Theme: "light"}, dest)
+}
@@ -29,6 +29,22 @@ func (v *TimeSchema) setCoercer(c conf.CoercerFunc) {
 	v.coercer = c
 }
 
+// Returns a deep copy of the schema. Used to clone inner schemas of complex schemas
+func (v *TimeSchema) cloneSchema() ZogSchema {
+	return v.Clone()
+}
+
+// Returns the description of the schema. See z.Describe
+func (v *TimeSchema) describe(d *describer) *SchemaDescription {
+	desc := d.new(v, v.getType())
+	desc.GoType = v.goType()
+	desc.Required = v.required != nil
+	desc.Default = derefValue(v.defaultVal)
+	desc.Catch = derefValue(v.catch)
+	desc.Tests = describeTests(v.processors)
+	return desc
+}
+
 type TimeFunc func(opts ...SchemaOption) *TimeSchema
 
 // ! USER FACING FUNCTIONS
@@ -118,6 +134,22 @@ func (v *TimeSchema) Transform(transform Transform[*time.Time]) *TimeSchema {
 	return v
 }
 
+// Returns a deep copy of the schema. Tests, transforms, required, default & catch values are copied so changing the copy doesn't affect the original
+func (v *TimeSchema) Clone() *TimeSchema {
+	return &TimeSchema{
+		processors: p.CloneProcessors(v.processors),
+		defaultVal: clonePtr(v.defaultVal),
+		required:   v.required.Clone(),
+		catch:      clonePtr(v.catch),
+		coercer:    v.coercer,
+	}
+}
+
+// Returns a walkable description of the schema. See z.Describe
+func (v *TimeSchema) Describe() *SchemaDescription {
+	return Describe(v)
+}
+
 // ! MODIFIERS
 
 // marks field as required
@@ -0,0 +1,342 @@
+package zog
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/Oudwins/zog/conf"
+	p "github.com/Oudwins/zog/internals"
+	"github.com/Oudwins/zog/zconst"
+)
+
+var _ ComplexZogSchema = &TupleSchema{}
+
+type TupleSchema struct {
+	processors []p.ZProcessor[any]
+	schemas    []ZogSchema
+	rest       ZogSchema
+	required   *p.Test[any]
+	coercer    conf.CoercerFunc
+}
+
+// Returns the type of the schema
+func (v *TupleSchema) getType() zconst.ZogType {
+	return zconst.TypeTuple
+}
+
+// Sets the coercer for the schema
+func (v *TupleSchema) setCoercer(c conf.CoercerFunc) {
+	v.coercer = c
+}
+
+// Returns a deep copy of the schema. Used to clone inner schemas of complex schemas
+func (v *TupleSchema) cloneSchema() ZogSchema {
+	return v.Clone()
+}
+
+// Returns the description of the schema. See z.Describe
+func (v *TupleSchema) describe(d *describer) *SchemaDescription {
+	desc := d.new(v, v.getType())
+	desc.Required = v.required != nil
+	desc.Tests = describeTests(v.processors)
+	desc.Items = make([]*SchemaDescription, len(v.schemas))
+	for i, schema := range v.schemas {
+		desc.Items[i] = d.schema(schema)
+	}
+	if v.rest != nil {
+		desc.Schema = d.schema(v.rest)
+	}
+	return desc
+}
+
+// ! USER FACING FUNCTIONS
+
+// Creates a tuple schema. That is a list where each position has its own schema. i.e GeoJSON coordinates ([lng, lat]) or [key, value] pairs. Usage:
+//
+//	coords := z.Tuple(z.Float64().GTE(-180).LTE(180), z.Float64().GTE(-90).LTE(90))
+//	var dest [2]float64
+//	errs := coords.Parse([]any{2.17, 41.38}, &dest)
+//
+// The destination can be an array, a slice or a struct, in which case each position is parsed into the exported field declared at that position.
+// The input must have exactly one item per schema unless Rest is used. Issues for items are reported at their index. i.e [1]
+func Tuple(schemas ...ZogSchema) *TupleSchema {
+	return &TupleSchema{
+		schemas: schemas,
+		coercer: conf.Coercers.Tuple,
+	}
+}
+
+// Parses the data into the destination array, slice or struct
+func (v *TupleSchema) Parse(data any, dest any, options ...ExecOption) ZogIssueList {
+	errs := p.NewErrsList()
+	defer errs.Free()
+	ctx := p.NewExecCtx(errs, conf.IssueFormatter)
+	defer ctx.Free()
+	for _, opt := range options {
+		opt(ctx)
+	}
+	path := p.NewPathBuilder()
+	defer path.Free()
+	sctx := ctx.NewSchemaCtx(data, dest, path, v.getType())
+	defer sctx.Free()
+	v.process(sctx)
+
+	return errs.List
+}
+
+// Internal function to process the data
+func (v *TupleSchema) process(ctx *p.SchemaCtx) {
+	if !resolveDataProvider(ctx) {
+		return
+	}
+	if p.IsParseZeroValue(ctx.Data, ctx) {
+		if v.required != nil {
+			ctx.AddIssue(ctx.IssueFromTest(v.required, ctx.Data))
+		}
+		return
+	}
+	data, err := v.coercer(ctx.Data)
+	if err != nil {
+		ctx.AddIssue(ctx.IssueFromCoerce(err))
+		return
+	}
+	refVal := reflect.ValueOf(data)
+	if !v.checkLen(ctx, refVal.Len(), ctx.Data) {
+		return
+	}
+
+	destVal := reflect.ValueOf(ctx.ValPtr)
+	if destVal.Kind() != reflect.Pointer {
+		p.Panicf(p.PanicInvalidArgumentsExpectedPointer)
+	}
+	ptrs := v.itemPtrs(ctx, destVal.Elem(), refVal.Len())
+	completed := eachChild(ctx, len(ptrs), v.getType(), func(idx int, subCtx *p.SchemaCtx) {
+		schema := v.schemaAt(idx)
+		k := fmt.Sprintf("[%d]", idx)
+		subCtx.Data = refVal.Index(idx).Interface()
+		subCtx.ValPtr = ptrs[idx]
+		subCtx.DType = schema.getType()
+		subCtx.Path.Push(&k)
+		schema.process(subCtx)
+		subCtx.Path.Pop()
+	})
+	if !completed {
+		return
+	}
+	v.runProcessors(ctx)
+}
+
+// Validates the array, slice or struct the pointer points to
+func (v *TupleSchema) Validate(dataPtr any, options ...ExecOption) ZogIssueList {
+	errs := p.NewErrsList()
+	defer errs.Free()
+	ctx := p.NewExecCtx(errs, conf.IssueFormatter)
+	defer ctx.Free()
+	for _, opt := range options {
+		opt(ctx)
+	}
+	path := p.NewPathBuilder()
+	defer path.Free()
+	sctx := ctx.NewSchemaCtx(dataPtr, dataPtr, path, v.getType())
+	defer sctx.Free()
+	v.validate(sctx)
+
+	return errs.List
+}
+
+// Internal function to validate the data
+func (v *TupleSchema) validate(ctx *p.SchemaCtx) {
+	destVal := reflect.ValueOf(ctx.ValPtr)
+	if destVal.Kind() != reflect.Pointer {
+		p.Panicf(p.PanicInvalidArgumentsExpectedPointer)
+	}
+	// only nil & empty slices are missing. Zero arrays & structs (i.e [0, 0]) are valid tuples
+	if destVal.IsNil() || (destVal.Elem().Kind() == reflect.Slice && destVal.Elem().Len() == 0) {
+		if v.required != nil {
+			ctx.AddIssue(ctx.IssueFromTest(v.required, ctx.ValPtr))
+		}
+		return
+	}
+
+	ptrs := v.itemPtrs(ctx, destVal.Elem(), -1)
+	if !v.checkLen(ctx, len(ptrs), ctx.ValPtr) {
+		return
+	}
+	completed := eachChild(ctx, len(ptrs), v.getType(), func(idx int, subCtx *p.SchemaCtx) {
+		schema := v.schemaAt(idx)
+		k := fmt.Sprintf("[%d]", idx)
+		subCtx.Data = ptrs[idx]
+		subCtx.ValPtr = ptrs[idx]
+		subCtx.DType = schema.getType()
+		subCtx.Path.Push(&k)
+		schema.validate(subCtx)
+		subCtx.Path.Pop()
+	})
+	if !completed {
+		return
+	}
+	v.runProcessors(ctx)
+}
+
+func (v *TupleSchema) runProcessors(ctx *p.SchemaCtx) {
+	for _, processor := range v.processors {
+		ctx.Processor = processor
+		processor.ZProcess(ctx.ValPtr, ctx)
+		if ctx.Exit {
+			return
+		}
+	}
+}
+
+// returns the schema for the item at idx
+func (v *TupleSchema) schemaAt(idx int) ZogSchema {
+	if idx < len(v.schemas) {
+		return v.schemas[idx]
+	}
+	return v.rest
+}
+
+// adds a len (or min if there is a rest schema) issue if the number of items is wrong
+func (v *TupleSchema) checkLen(ctx *p.SchemaCtx, n int, val any) bool {
+	if v.rest == nil && n != len(v.schemas) {
+		ctx.AddIssue(ctx.Issue().SetCode(zconst.IssueCodeLen).SetValue(val).SetParams(map[string]any{
+			zconst.IssueCodeLen: len(v.schemas),
+		}))
+		return false
+	}
+	if v.rest != nil && n < len(v.schemas) {
+		ctx.AddIssue(ctx.Issue().SetCode(zconst.IssueCodeMin).SetValue(val).SetParams(map[string]any{
+			zconst.IssueCodeMin: len(v.schemas),
+		}))
+		return false
+	}
+	return true
+}
+
+// returns a pointer to the destination of each item. If n >= 0 the destination is allocated for n items (parse), otherwise the items already in it are used (validate)
+func (v *TupleSchema) itemPtrs(ctx *p.SchemaCtx, dest reflect.Value, n int) []any {
+	switch dest.Kind() {
+	case reflect.Array:
+		if v.rest != nil || dest.Len() != len(v.schemas) {
+			p.Panicf(p.PanicTupleDestination, ctx.String(), fmt.Sprintf("Array destinations must have exactly %d elements and can't be used with Rest, got %s", len(v.schemas), dest.Type()))
+		}
+		return elemPtrs(dest)
+	case reflect.Slice:
+		if n >= 0 {
+			dest.Set(reflect.MakeSlice(dest.Type(), n, n))
+		}
+		return elemPtrs(dest)
+	case reflect.Struct:
+		var fields []int
+		for i := 0; i < dest.NumField(); i++ {
+			if dest.Type().Field(i).IsExported() {
+				fields = append(fields, i)
+			}
+		}
+		want := len(v.schemas)
+		if v.rest != nil {
+			want++
+		}
+		if len(fields) < want {
+			p.Panicf(p.PanicTupleDestination, ctx.String(), fmt.Sprintf("Struct destinations must have an exported field per position (plus a slice field for Rest), %s has %d", dest.Type(), len(fields)))
+		}
+		ptrs := make([]any, 0, want)
+		for i := range v.schemas {
+			ptrs = append(ptrs, dest.Field(fields[i]).Addr().Interface())
+		}
+		if v.rest != nil {
+			restField := dest.Field(fields[len(v.schemas)])
+			if restField.Kind() != reflect.Slice {
+				p.Panicf(p.PanicTupleDestination, ctx.String(), fmt.Sprintf("The field after the positional fields of %s must be a slice to hold the Rest items", dest.Type()))
+			}
+			if n >= 0 {
+				restField.Set(reflect.MakeSlice(restField.Type(), n-len(v.schemas), n-len(v.schemas)))
+			}
+			ptrs = append(ptrs, elemPtrs(restField)...)
+		}
+		return ptrs
+	default:
+		p.Panicf(p.PanicTupleDestination, ctx.String(), fmt.Sprintf("The destination must be an array, slice or struct, got %s", dest.Type()))
+		return nil
+	}
+}
+
+// returns a pointer to each element of the array or slice
+func elemPtrs(val reflect.Value) []any {
+	ptrs := make([]any, val.Len())
+	for i := range ptrs {
+		ptrs[i] = val.Index(i).Addr().Interface()
+	}
+	return ptrs
+}
+
+// Adds transform function to schema.
+func (v *TupleSchema) Transform(transform Transform[any]) *TupleSchema {
+	v.processors = append(v.processors, &p.TransformProcessor[any]{
+		Transform: p.Transform[any](transform),
+	})
+	return v
+}
+
+// Returns a deep copy of the schema. Item schemas, tests & required are copied so changing the copy doesn't affect the original
+func (v *TupleSchema) Clone() *TupleSchema {
+	schemas := make([]ZogSchema, len(v.schemas))
+	for i, schema := range v.schemas {
+		schemas[i] = schema.cloneSchema()
+	}
+	c := &TupleSchema{
+		processors: p.CloneProcessors(v.processors),
+		schemas:    schemas,
+		required:   v.required.Clone(),
+		coercer:    v.coercer,
+	}
+	if v.rest != nil {
+		c.rest = v.rest.cloneSchema()
+	}
+	return c
+}
+
+// Returns a walkable description of the schema. See z.Describe
+func (v *TupleSchema) Describe() *SchemaDescription {
+	return Describe(v)
+}
+
+// !MODIFIERS
+
+// Sets the schema for the items after the positional ones. Without it the input must have exactly one item per positional schema
+func (v *TupleSchema) Rest(schema ZogSchema) *TupleSchema {
+	v.rest = schema
+	return v
+}
+
+// marks field as required
+func (v *TupleSchema) Required(options ...TestOption) *TupleSchema {
+	r := p.Required[any]()
+	for _, opt := range options {
+		opt(&r)
+	}
+	v.required = &r
+	return v
+}
+
+// marks field as optional
+func (v *TupleSchema) Optional() *TupleSchema {
+	v.required = nil
+	return v
+}
+
+// !TESTS
+
+// custom test function call it -> schema.Test(t z.Test)
+func (v *TupleSchema) Test(t Test[any]) *TupleSchema {
+	x := p.Test[any](t)
+	v.processors = append(v.processors, &x)
+	return v
+}
+
+// Create a custom test function for the schema. This is similar to Zod's `.refine()` method. The test gets a pointer to the destination
+func (v *TupleSchema) TestFunc(testFunc BoolTFunc[any], opts ...TestOption) *TupleSchema {
+	t := p.NewTestFunc("", p.BoolTFunc[any](testFunc), opts...)
+	v.Test(Test[any](*t))
+	return v
+}
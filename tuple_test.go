@@ -0,0 +1,220 @@
+package zog
+
+import (
+	"testing"
+
+	"github.com/Oudwins/zog/tutils"
+	"github.com/Oudwins/zog/zconst"
+	"github.com/stretchr/testify/assert"
+)
+
+var coordsSchema = Tuple(Float64().GTE(-180).LTE(180), Float64().GTE(-90).LTE(90))
+
+func TestTupleParseArray(t *testing.T) {
+	var dest [2]float64
+	errs := coordsSchema.Parse([]any{2.17, 41.38}, &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, [2]float64{2.17, 41.38}, dest)
+
+	errs = coordsSchema.Parse([]any{2.17, 100}, &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeLTE, errs[0].Code)
+	assert.Equal(t, []string{"[1]"}, errs[0].Path)
+	assert.Equal(t, zconst.TypeNumber, errs[0].Dtype)
+	tutils.VerifyDefaultIssueMessages(t, errs)
+}
+
+func TestTupleParseLen(t *testing.T) {
+	var dest [2]float64
+	errs := coordsSchema.Parse([]any{2.17}, &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeLen, errs[0].Code)
+	assert.Equal(t, zconst.TypeTuple, errs[0].Dtype)
+	assert.Empty(t, errs[0].Path)
+	tutils.VerifyDefaultIssueMessages(t, errs)
+
+	errs = coordsSchema.Parse("not a tuple", &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeCoerce, errs[0].Code)
+	tutils.VerifyDefaultIssueMessages(t, errs)
+}
+
+func TestTupleParseStruct(t *testing.T) {
+	type Entry struct {
+		Key   string
+		Value int
+	}
+	var dest Entry
+	errs := Tuple(String().Required(), Int().GT(0)).Parse([]any{"a", 3}, &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, Entry{Key: "a", Value: 3}, dest)
+
+	errs = Tuple(String().Required(), Int().GT(0)).Parse([2]any{nil, 0}, &dest)
+	assert.Len(t, errs, 2)
+	assert.Equal(t, []string{"[0]"}, errs[0].Path)
+	assert.Equal(t, zconst.IssueCodeRequired, errs[0].Code)
+	assert.Equal(t, zconst.TypeString, errs[0].Dtype)
+	assert.Equal(t, []string{"[1]"}, errs[1].Path)
+	assert.Equal(t, zconst.IssueCodeGT, errs[1].Code)
+}
+
+func TestTupleRest(t *testing.T) {
+	type Command struct {
+		Name string
+		Args []int
+	}
+	schema := Tuple(String().Required()).Rest(Int().GT(0))
+	var dest Command
+	errs := schema.Parse([]any{"add", 1, 2, 3}, &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, Command{Name: "add", Args: []int{1, 2, 3}}, dest)
+
+	errs = schema.Parse([]any{"add", 1, -2}, &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, []string{"[2]"}, errs[0].Path)
+
+	errs = schema.Parse(nil, &dest)
+	assert.Empty(t, errs)
+	errs = schema.Required().Parse(nil, &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeRequired, errs[0].Code)
+
+	var strs []string
+	errs = Tuple(String(), String()).Rest(String().Min(2)).Parse([]any{"a", "b", "cd", "e"}, &strs)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, []string{"[3]"}, errs[0].Path)
+	assert.Equal(t, []string{"a", "b", "cd", "e"}, strs)
+
+	errs = Tuple(String(), String()).Rest(String()).Parse([]any{"a"}, &strs)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeMin, errs[0].Code)
+	tutils.VerifyDefaultIssueMessages(t, errs)
+}
+
+func TestTupleNested(t *testing.T) {
+	type Route struct {
+		Points [][2]float64
+	}
+	schema := Struct(Shape{
+		"points": Slice(coordsSchema).Min(2),
+	})
+	var dest Route
+	errs := schema.Parse(map[string]any{"points": []any{[]any{1, 2}, []any{3, 95}}}, &dest, WithParallelism(2))
+	assert.Len(t, errs, 1)
+	assert.Equal(t, []string{"points", "[1]", "[1]"}, errs[0].Path)
+	assert.Equal(t, [][2]float64{{1, 2}, {3, 95}}, dest.Points)
+}
+
+func TestTupleValidate(t *testing.T) {
+	dest := [2]float64{2.17, 100}
+	errs := coordsSchema.Validate(&dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, []string{"[1]"}, errs[0].Path)
+
+	type Command struct {
+		Name string
+		Args []int
+	}
+	schema := Tuple(String().Required()).Rest(Int().GT(0)).TestFunc(func(val any, ctx Ctx) bool {
+		return len(val.(*Command).Args) <= 2
+	})
+	cmd := Command{Name: "add", Args: []int{1, -1}}
+	errs = schema.Validate(&cmd)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, []string{"[2]"}, errs[0].Path)
+
+	cmd = Command{Name: "add", Args: []int{1, 2, 3}}
+	errs = schema.Validate(&cmd)
+	assert.Len(t, errs, 1)
+	assert.Empty(t, errs[0].Path)
+
+	strs := []string{"a"}
+	errs = Tuple(String(), String()).Validate(&strs)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeLen, errs[0].Code)
+
+	var empty []float64
+	errs = Tuple(Float64(), Float64()).Required().Validate(&empty)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeRequired, errs[0].Code)
+}
+
+func TestTupleValidateZeroArray(t *testing.T) {
+	// [0, 0] is a valid coordinate pair, not a missing tuple
+	var origin [2]float64
+	errs := Tuple(Float64(), Float64()).Required().Validate(&origin)
+	assert.Empty(t, errs)
+
+	// the item schemas & tuple tests still run
+	called := false
+	errs = Tuple(Float64().Required(), Float64()).TestFunc(func(val any, ctx Ctx) bool {
+		called = true
+		return true
+	}).Required().Validate(&origin)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeRequired, errs[0].Code)
+	assert.Equal(t, []string{"[0]"}, errs[0].Path)
+
+	errs = Tuple(Float64(), Float64()).TestFunc(func(val any, ctx Ctx) bool {
+		called = true
+		return true
+	}).Validate(&origin)
+	assert.Empty(t, errs)
+	assert.True(t, called)
+
+	type Point struct {
+		X int
+		Y int
+	}
+	var p Point
+	errs = Tuple(Int().Required(), Int()).Validate(&p)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, []string{"[0]"}, errs[0].Path)
+}
+
+func TestTupleDescribeAndClone(t *testing.T) {
+	schema := Tuple(String(), Int()).Rest(Bool()).Required()
+	desc := schema.Describe()
+	assert.Equal(t, zconst.TypeTuple, desc.Type)
+	assert.True(t, desc.Required)
+	assert.Len(t, desc.Items, 2)
+	assert.Equal(t, zconst.TypeNumber, desc.Items[1].Type)
+	assert.Equal(t, zconst.TypeBool, desc.Schema.Type)
+
+	var visited []zconst.ZogType
+	desc.Walk(func(d *SchemaDescription) bool {
+		visited = append(visited, d.Type)
+		return true
+	})
+	assert.Equal(t, []zconst.ZogType{zconst.TypeTuple, zconst.TypeBool, zconst.TypeString, zconst.TypeNumber}, visited)
+
+	clone := schema.Clone().Optional()
+	assert.NotSame(t, schema.schemas[0], clone.schemas[0])
+	assert.True(t, schema.Describe().Required)
+}
+
+func TestTuplePanics(t *testing.T) {
+	// array with the wrong length
+	assert.Panics(t, func() {
+		var dest [3]float64
+		coordsSchema.Parse([]any{1, 2}, &dest)
+	})
+	// array with rest
+	assert.Panics(t, func() {
+		var dest [2]float64
+		Tuple(Float64()).Rest(Float64()).Parse([]any{1, 2}, &dest)
+	})
+	// struct without a slice for the rest items
+	assert.Panics(t, func() {
+		var dest struct {
+			A string
+			B string
+		}
+		Tuple(String()).Rest(String()).Parse([]any{"a", "b"}, &dest)
+	})
+	// unsupported destination
+	assert.Panics(t, func() {
+		var dest map[string]any
+		coordsSchema.Parse([]any{1, 2}, &dest)
+	})
+}
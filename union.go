@@ -0,0 +1,168 @@
+package zog
+
+import (
+	"reflect"
+
+	"github.com/Oudwins/zog/conf"
+	p "github.com/Oudwins/zog/internals"
+	"github.com/Oudwins/zog/zconst"
+)
+
+var _ ComplexZogSchema = &UnionSchema{}
+
+type UnionSchema struct {
+	schemas []ZogSchema
+}
+
+// Returns the type of the schema
+func (v *UnionSchema) getType() zconst.ZogType {
+	return zconst.TypeUnion
+}
+
+// Sets the coercer for all the schemas in the union
+func (v *UnionSchema) setCoercer(c CoercerFunc) {
+	for _, s := range v.schemas {
+		s.setCoercer(c)
+	}
+}
+
+// Returns a deep copy of the schema. Used to clone inner schemas of complex schemas
+func (v *UnionSchema) cloneSchema() ZogSchema {
+	return v.Clone()
+}
+
+// Returns the description of the schema. See z.Describe
+func (v *UnionSchema) describe(d *describer) *SchemaDescription {
+	desc := d.new(v, v.getType())
+	desc.Options = make([]*SchemaDescription, len(v.schemas))
+	for i, s := range v.schemas {
+		desc.Options[i] = d.schema(s)
+	}
+	return desc
+}
+
+// ! USER FACING FUNCTIONS
+
+// Creates a union schema. Similar to Zod's `z.union()`.
+// Each schema is tried in order and the first one that parses/validates without issues wins.
+// If none of them succeed a single `invalid_union` issue is returned. Its params contain the issues for each of the schemas (in order) under the `invalid_union` key.
+// All the schemas must be able to write to the same destination type.
+func Union(schemas ...ZogSchema) *UnionSchema {
+	return &UnionSchema{schemas: schemas}
+}
+
+// Returns a deep copy of the schema and every schema in the union
+func (v *UnionSchema) Clone() *UnionSchema {
+	schemas := make([]ZogSchema, len(v.schemas))
+	for i, s := range v.schemas {
+		schemas[i] = s.cloneSchema()
+	}
+	return &UnionSchema{schemas: schemas}
+}
+
+// Returns a walkable description of the schema. See z.Describe
+func (v *UnionSchema) Describe() *SchemaDescription {
+	return Describe(v)
+}
+
+// Parses the data into the destination using the first schema in the union that succeeds
+func (v *UnionSchema) Parse(data any, dest any, options ...ExecOption) ZogIssueList {
+	errs := p.NewErrsList()
+	defer errs.Free()
+	ctx := p.NewExecCtx(errs, conf.IssueFormatter)
+	defer ctx.Free()
+	for _, opt := range options {
+		opt(ctx)
+	}
+	path := p.NewPathBuilder()
+	defer path.Free()
+	sctx := ctx.NewSchemaCtx(data, dest, path, v.getType())
+	defer sctx.Free()
+	v.process(sctx)
+
+	return errs.List
+}
+
+// Internal function to process the data
+func (v *UnionSchema) process(ctx *p.SchemaCtx) {
+	destVal := reflect.ValueOf(ctx.ValPtr)
+	if destVal.Kind() != reflect.Pointer {
+		p.Panicf(p.PanicInvalidArgumentsExpectedPointer)
+	}
+	// resolve data provider factories once. Otherwise every member would try to consume the input (i.e the request body)
+	if factory, ok := ctx.Data.(p.DpFactory); ok {
+		dp, err := factory()
+		if err != nil {
+			ctx.AddIssue(ctx.IssueFromUnknownError(err))
+			return
+		}
+		ctx.Data = dp
+	}
+	v.run(ctx, destVal, false)
+}
+
+// Validates the value using the first schema in the union that succeeds
+func (v *UnionSchema) Validate(dataPtr any, options ...ExecOption) ZogIssueList {
+	errs := p.NewErrsList()
+	defer errs.Free()
+	ctx := p.NewExecCtx(errs, conf.IssueFormatter)
+	defer ctx.Free()
+	for _, opt := range options {
+		opt(ctx)
+	}
+	path := p.NewPathBuilder()
+	defer path.Free()
+	sctx := ctx.NewSchemaCtx(dataPtr, dataPtr, path, v.getType())
+	defer sctx.Free()
+	v.validate(sctx)
+
+	return errs.List
+}
+
+// Internal function to validate the data
+func (v *UnionSchema) validate(ctx *p.SchemaCtx) {
+	destVal := reflect.ValueOf(ctx.ValPtr)
+	if destVal.Kind() != reflect.Pointer {
+		p.Panicf(p.PanicInvalidArgumentsExpectedPointer)
+	}
+	v.run(ctx, destVal, true)
+}
+
+// runs every schema against its own issue list and a scratch destination so failed attempts don't leak issues or partial values
+func (v *UnionSchema) run(ctx *p.SchemaCtx, destVal reflect.Value, isValidate bool) {
+	branchIssues := make([]ZogIssueList, 0, len(v.schemas))
+	for _, schema := range v.schemas {
+		tmp := reflect.New(destVal.Type().Elem())
+		if isValidate {
+			tmp.Elem().Set(destVal.Elem())
+		}
+
+		errs := p.NewErrsList()
+		execCtx := ctx.ExecCtx.Fork(errs)
+		var data any = ctx.Data
+		if isValidate {
+			data = tmp.Interface()
+		}
+		subCtx := execCtx.NewSchemaCtx(data, tmp.Interface(), ctx.Path, schema.getType())
+		if isValidate {
+			schema.validate(subCtx)
+		} else {
+			schema.process(subCtx)
+		}
+		subCtx.Free()
+		execCtx.Free()
+
+		list := errs.List
+		errs.Free()
+		if len(list) == 0 {
+			destVal.Elem().Set(tmp.Elem())
+			return
+		}
+		branchIssues = append(branchIssues, list)
+	}
+
+	issue := ctx.Issue().SetCode(zconst.IssueCodeInvalidUnion).SetParams(map[string]any{
+		zconst.IssueCodeInvalidUnion: branchIssues,
+	})
+	ctx.AddIssue(issue)
+}
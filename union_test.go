@@ -0,0 +1,140 @@
+package zog
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/Oudwins/zog/tutils"
+	"github.com/Oudwins/zog/zconst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnionParseFirstMatchWins(t *testing.T) {
+	schema := Union(
+		String().Email(),
+		String().URL(),
+	)
+	var dest string
+	errs := schema.Parse("foo@bar.com", &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, "foo@bar.com", dest)
+
+	errs = schema.Parse("https://zog.dev", &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, "https://zog.dev", dest)
+}
+
+func TestUnionParseNoMatch(t *testing.T) {
+	schema := Union(
+		String().Email(),
+		String().URL(),
+	)
+	dest := "untouched"
+	errs := schema.Parse("not valid", &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeInvalidUnion, errs[0].Code)
+	assert.Equal(t, zconst.TypeUnion, errs[0].Dtype)
+	assert.Equal(t, "untouched", dest)
+
+	branches := errs[0].Params[zconst.IssueCodeInvalidUnion].([]ZogIssueList)
+	assert.Len(t, branches, 2)
+	assert.Equal(t, zconst.IssueCodeEmail, branches[0][0].Code)
+	assert.Equal(t, zconst.IssueCodeURL, branches[1][0].Code)
+	assert.NotEmpty(t, branches[0][0].Message)
+	tutils.VerifyDefaultIssueMessages(t, errs)
+}
+
+func TestUnionParseNumberOrNumericString(t *testing.T) {
+	schema := Union(
+		Int(WithCoercer(func(data any) (any, error) {
+			v, ok := data.(int)
+			if !ok {
+				return nil, strconv.ErrSyntax
+			}
+			return v, nil
+		})).GT(10),
+		Preprocess(func(data string, ctx Ctx) (int, error) {
+			return strconv.Atoi(data)
+		}, Int()),
+	)
+	var dest int
+	errs := schema.Parse(20, &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, 20, dest)
+
+	errs = schema.Parse("5", &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, 5, dest)
+
+	errs = schema.Parse(true, &dest)
+	assert.Len(t, errs, 1)
+}
+
+func TestUnionInStruct(t *testing.T) {
+	type Contact struct {
+		Handle string
+	}
+	schema := Struct(Shape{
+		"handle": Union(String().Email(), String().HasPrefix("@")),
+	})
+	var dest Contact
+	errs := schema.Parse(map[string]any{"handle": "@zog"}, &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, "@zog", dest.Handle)
+
+	errs = schema.Parse(map[string]any{"handle": "zog"}, &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, []string{"handle"}, errs[0].Path)
+	branches := errs[0].Params[zconst.IssueCodeInvalidUnion].([]ZogIssueList)
+	assert.Equal(t, []string{"handle"}, branches[0][0].Path)
+}
+
+func TestUnionOfStructs(t *testing.T) {
+	type Payment struct {
+		Card string
+		Iban string
+	}
+	schema := Union(
+		Struct(Shape{"card": String().Required().Len(4)}),
+		Struct(Shape{"iban": String().Required()}),
+	)
+	var dest Payment
+	errs := schema.Parse(map[string]any{"iban": "ES12"}, &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, Payment{Iban: "ES12"}, dest)
+}
+
+func TestUnionValidate(t *testing.T) {
+	schema := Union(
+		String().Email(),
+		String().Trim().URL(),
+	)
+	dest := " https://zog.dev "
+	errs := schema.Validate(&dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, "https://zog.dev", dest)
+
+	dest = "nope"
+	errs = schema.Validate(&dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeInvalidUnion, errs[0].Code)
+	assert.Equal(t, "nope", dest)
+	tutils.VerifyDefaultIssueMessages(t, errs)
+}
+
+func TestUnionValidateInStruct(t *testing.T) {
+	type Contact struct {
+		Handle string
+	}
+	schema := Struct(Shape{
+		"handle": Union(String().Email(), String().HasPrefix("@")),
+	})
+	dest := Contact{Handle: "zog"}
+	errs := schema.Validate(&dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "handle", errs[0].PathString())
+
+	dest = Contact{Handle: "a@b.com"}
+	errs = schema.Validate(&dest)
+	assert.Empty(t, errs)
+}
@@ -1,6 +1,8 @@
 package zog
 
 import (
+	"context"
+
 	"github.com/Oudwins/zog/conf"
 	p "github.com/Oudwins/zog/internals"
 	"github.com/Oudwins/zog/zconst"
@@ -94,3 +96,43 @@ func WithCtxValue(key string, val any) ExecOption {
 		p.Set(key, val)
 	}
 }
+
+// Sets the max number of goroutines used to execute the schema. Slice items & struct fields are split between them and the issues are returned in the same order as a sequential execution would return them. Usage:
+//
+//	schema.Parse(data, &dest, z.WithParallelism(runtime.NumCPU()))
+//
+// n <= 1 means sequential execution (the default). Tests & transforms must be safe to call concurrently when it is enabled
+func WithParallelism(n int) ExecOption {
+	return func(p *p.ExecCtx) {
+		p.SetParallelism(n)
+	}
+}
+
+// Stops the execution after the first issue. Useful when you only need to know if the data is valid. Usage:
+//
+//	errs := schema.Parse(data, &dest, z.WithAbortEarly())
+//	valid := len(errs) == 0
+func WithAbortEarly() ExecOption {
+	return func(p *p.ExecCtx) {
+		p.SetMaxIssues(1, false)
+	}
+}
+
+// Stops the execution after n issues. If there are more issues than that, a max_issues issue is added at the end of the list to signal it was truncated. n <= 0 means no limit.
+// Issues discarded by catch or by union members don't count. With z.WithParallelism the issues kept are the first n found, which may differ between executions
+func WithMaxIssues(n int) ExecOption {
+	return func(p *p.ExecCtx) {
+		p.SetMaxIssues(n, true)
+	}
+}
+
+// Sets the context.Context for the execution. Tests & transforms can get it with ctx.Context(). Usage:
+//
+//	schema.Parse(data, &dest, z.WithContext(r.Context()))
+//
+// Structs, slices & maps check it between fields & items. Once it is done the execution stops and a cancelled issue is added
+func WithContext(ctx context.Context) ExecOption {
+	return func(p *p.ExecCtx) {
+		p.SetContext(ctx)
+	}
+}
@@ -1,6 +1,7 @@
 package zog
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -120,3 +121,205 @@ func TestWithCoercer(t *testing.T) {
 	assert.Empty(t, err)
 	assert.Equal(t, "010203", out2.Bytes)
 }
+
+func TestWithContext(t *testing.T) {
+	var ctx = p.NewExecCtx(nil, nil)
+	assert.Equal(t, context.Background(), ctx.Context())
+
+	type key struct{}
+	goCtx := context.WithValue(context.Background(), key{}, "bar")
+	WithContext(goCtx)(ctx)
+	assert.Equal(t, goCtx, ctx.Context())
+
+	var got any
+	var out string
+	errs := String().TestFunc(func(val *string, ctx Ctx) bool {
+		got = ctx.Context().Value(key{})
+		return true
+	}).Parse("foo", &out, WithContext(goCtx))
+	assert.Empty(t, errs)
+	assert.Equal(t, "bar", got)
+}
+
+func TestWithContextCancelled(t *testing.T) {
+	type User struct {
+		Name  string
+		Email string
+		Tags  []string
+	}
+	goCtx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	// every field cancels the context so only the first field that runs is processed
+	cancelOnTest := func(val *string, ctx Ctx) bool {
+		calls++
+		cancel()
+		return true
+	}
+	schema := Struct(Shape{
+		"name":  String().TestFunc(cancelOnTest),
+		"email": String().TestFunc(cancelOnTest),
+		"tags":  Slice(String().TestFunc(cancelOnTest)),
+	}).Catch(User{Name: "caught"})
+
+	var out User
+	errs := schema.Parse(map[string]any{"name": "a", "email": "b", "tags": []string{"c", "d"}}, &out, WithContext(goCtx))
+	assert.Equal(t, 1, calls)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeCancelled, errs[0].Code)
+	assert.ErrorIs(t, errs[0].Err, context.Canceled)
+	assert.NotEqual(t, "caught", out.Name)
+	tutils.VerifyDefaultIssueMessages(t, errs)
+
+	out = User{Name: "a", Email: "b", Tags: []string{"c", "d"}}
+	errs = schema.Validate(&out, WithContext(goCtx))
+	assert.Equal(t, 1, calls)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeCancelled, errs[0].Code)
+
+	// items of slices & maps
+	goCtx, cancel = context.WithCancel(context.Background())
+	calls = 0
+	var items []string
+	errs = Slice(String().TestFunc(cancelOnTest)).Parse([]string{"a", "b", "c"}, &items, WithContext(goCtx))
+	assert.Equal(t, 1, calls)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeCancelled, errs[0].Code)
+
+	goCtx, cancel = context.WithCancel(context.Background())
+	calls = 0
+	m := map[string]string{"a": "a", "b": "b"}
+	errs = Map(String(), String().TestFunc(cancelOnTest)).Validate(&m, WithContext(goCtx))
+	assert.Equal(t, 1, calls)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeCancelled, errs[0].Code)
+	cancel()
+}
+
+func TestWithParallelism(t *testing.T) {
+	type Item struct {
+		Name  string
+		Count int
+	}
+	schema := Slice(Struct(Shape{
+		"name":  String().Required().Min(3),
+		"count": Int().GTE(0),
+	}))
+
+	data := make([]any, 1000)
+	for i := range data {
+		item := map[string]any{"name": fmt.Sprintf("item-%d", i), "count": i}
+		if i%7 == 0 {
+			item["name"] = "x"
+		}
+		data[i] = item
+	}
+
+	var seq []Item
+	seqErrs := schema.Parse(data, &seq)
+	for _, n := range []int{2, 4, 16} {
+		var out []Item
+		errs := schema.Parse(data, &out, WithParallelism(n))
+		assert.Equal(t, seq, out)
+		assert.Equal(t, len(seqErrs), len(errs))
+		for i := range errs {
+			assert.Equal(t, seqErrs[i].Path, errs[i].Path)
+			assert.Equal(t, seqErrs[i].Code, errs[i].Code)
+		}
+		tutils.VerifyDefaultIssueMessages(t, errs)
+
+		errs = schema.Validate(&out, WithParallelism(n))
+		assert.Equal(t, len(seqErrs), len(errs))
+		for i := range errs {
+			assert.Equal(t, seqErrs[i].Path, errs[i].Path)
+		}
+	}
+	assert.Len(t, seqErrs, 143)
+	assert.Equal(t, []string{"[0]", "name"}, seqErrs[0].Path)
+}
+
+func TestWithParallelismStructFields(t *testing.T) {
+	type User struct {
+		A string
+		B string
+		C string
+		D []string
+	}
+	schema := Struct(Shape{
+		"a": String().Required(),
+		"b": String().Required(),
+		"c": String().Required(),
+		"d": Slice(String().Min(2)),
+	})
+
+	var out User
+	errs := schema.Parse(map[string]any{"d": []string{"a", "bb", "c"}}, &out, WithParallelism(4))
+	paths := make([]string, len(errs))
+	for i, e := range errs {
+		paths[i] = p.FlattenPath(e.Path)
+	}
+	assert.Equal(t, []string{"a", "b", "c", "d[0]", "d[2]"}, paths)
+
+	// schema definition panics are raised in the calling goroutine
+	assert.Panics(t, func() {
+		var out []struct{ A string }
+		Slice(Struct(Shape{"missing": String()})).Parse([]any{map[string]any{}, map[string]any{}}, &out, WithParallelism(4))
+	})
+}
+
+func TestWithAbortEarly(t *testing.T) {
+	calls := 0
+	item := String().Min(3).TestFunc(func(val *string, ctx Ctx) bool {
+		calls++
+		return true
+	})
+	schema := Slice(item)
+
+	var out []string
+	errs := schema.Parse([]string{"a", "b", "c"}, &out, WithAbortEarly())
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeMin, errs[0].Code)
+	assert.Equal(t, []string{"[0]"}, errs[0].Path)
+	assert.Equal(t, 1, calls)
+
+	errs = schema.Validate(&out, WithAbortEarly())
+	assert.Len(t, errs, 1)
+
+	// issues discarded by catch don't count
+	schema = Slice(Struct(Shape{"name": String().Min(3)}).Catch(struct{ Name string }{Name: "caught"}))
+	var structs []struct{ Name string }
+	errs = Slice(Struct(Shape{"name": String().Min(3)})).Parse([]any{map[string]any{"name": "a"}, map[string]any{"name": "b"}}, &structs, WithAbortEarly())
+	assert.Len(t, errs, 1)
+	errs = schema.Parse([]any{map[string]any{"name": "a"}, map[string]any{"name": "b"}}, &structs, WithAbortEarly())
+	assert.Empty(t, errs)
+	assert.Equal(t, "caught", structs[1].Name)
+}
+
+func TestWithMaxIssues(t *testing.T) {
+	schema := Slice(String().Min(3))
+	var out []string
+
+	errs := schema.Parse([]string{"a", "b", "c", "d", "e"}, &out, WithMaxIssues(2))
+	assert.Len(t, errs, 3)
+	assert.Equal(t, []string{"[0]"}, errs[0].Path)
+	assert.Equal(t, []string{"[1]"}, errs[1].Path)
+	assert.Equal(t, zconst.IssueCodeMaxIssues, errs[2].Code)
+	assert.Equal(t, 2, errs[2].Params[zconst.IssueCodeMaxIssues])
+	// the marker doesn't take the type of the dropped issue
+	assert.Equal(t, zconst.TypeExecution, errs[2].Dtype)
+	assert.Equal(t, "too many issues, execution stopped after 2", errs[2].Message)
+	tutils.VerifyDefaultIssueMessages(t, errs)
+
+	// no marker if the list was not truncated
+	errs = schema.Parse([]string{"a", "b"}, &out, WithMaxIssues(2))
+	assert.Len(t, errs, 2)
+
+	errs = schema.Validate(&out, WithMaxIssues(1))
+	assert.Len(t, errs, 2)
+	assert.Equal(t, zconst.IssueCodeMaxIssues, errs[1].Code)
+
+	// parallel executions keep n issues and add the marker last
+	data := make([]string, 1000)
+	errs = schema.Parse(data, &out, WithMaxIssues(10), WithParallelism(4))
+	assert.Len(t, errs, 11)
+	assert.Equal(t, zconst.IssueCodeMaxIssues, errs[10].Code)
+}
@@ -0,0 +1,188 @@
+package zog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Oudwins/zog/conf"
+	p "github.com/Oudwins/zog/internals"
+	"github.com/Oudwins/zog/zconst"
+)
+
+var _ ZogSchema = &WhenSchema{}
+
+// Schema for a struct field that depends on the value of a sibling field. See z.When & z.WhenFunc
+type WhenSchema struct {
+	// shape key of the sibling field
+	key       string
+	predicate func(val any) bool
+	then      ZogSchema
+	otherwise ZogSchema
+	// only set for z.WhenFunc. Returns the schema to use for the sibling value
+	fn func(val any) ZogSchema
+}
+
+// Creates a conditional schema for a struct field. If predicate returns true for the value of the sibling field with the shape key `key` the field uses then, otherwise it uses otherwise.
+// If the chosen schema is nil the field is skipped. Issues are reported at the path of the field. Usage:
+//
+//	z.Struct(z.Shape{
+//		"country": z.String().Required(),
+//		"vatNumber": z.When("country", func(country string) bool {
+//			return slices.Contains(euCountries, country)
+//		}, z.String().Required(), nil),
+//	})
+//
+// When fields must be values of a struct shape. They are executed after the other fields so the sibling is already parsed (or validated). The sibling must not be another When field.
+// Panics during execution if T is not the type of the sibling field
+func When[T any](key string, predicate func(val T) bool, then ZogSchema, otherwise ZogSchema) *WhenSchema {
+	return &WhenSchema{
+		key: key,
+		predicate: func(val any) bool {
+			return predicate(siblingValue[T](key, val))
+		},
+		then:      then,
+		otherwise: otherwise,
+	}
+}
+
+// Same as z.When but the schema for the field is created from the value of the sibling field. Useful for rules that compare both fields. Returning nil skips the field. Usage:
+//
+//	z.Struct(z.Shape{
+//		"startDate": z.Time().Required(),
+//		"endDate": z.WhenFunc("startDate", func(start time.Time) z.ZogSchema {
+//			return z.Time().After(start)
+//		}),
+//	})
+func WhenFunc[T any](key string, fn func(val T) ZogSchema) *WhenSchema {
+	return &WhenSchema{
+		key: key,
+		fn: func(val any) ZogSchema {
+			return fn(siblingValue[T](key, val))
+		},
+	}
+}
+
+func siblingValue[T any](key string, val any) T {
+	v, ok := val.(T)
+	if !ok {
+		p.Panicf(p.PanicWhenDefinition, key, fmt.Sprintf("Expected the sibling field to be of type %T but it is %T", *new(T), val))
+	}
+	return v
+}
+
+// Returns the type of the then schema or when if it is not known before execution
+func (v *WhenSchema) getType() zconst.ZogType {
+	if v.then != nil {
+		return v.then.getType()
+	}
+	return zconst.TypeWhen
+}
+
+// Sets the coercer for the then & otherwise schemas
+func (v *WhenSchema) setCoercer(c conf.CoercerFunc) {
+	if v.then != nil {
+		v.then.setCoercer(c)
+	}
+	if v.otherwise != nil {
+		v.otherwise.setCoercer(c)
+	}
+}
+
+// Returns a deep copy of the schema. Used to clone inner schemas of complex schemas
+func (v *WhenSchema) cloneSchema() ZogSchema {
+	return v.Clone()
+}
+
+// Returns the description of the schema. See z.Describe
+func (v *WhenSchema) describe(d *describer) *SchemaDescription {
+	desc := d.new(v, zconst.TypeWhen)
+	desc.Condition = v.key
+	if v.then != nil {
+		desc.Then = d.schema(v.then)
+	}
+	if v.otherwise != nil {
+		desc.Otherwise = d.schema(v.otherwise)
+	}
+	return desc
+}
+
+// Returns a deep copy of the schema. The then & otherwise schemas are copied
+func (v *WhenSchema) Clone() *WhenSchema {
+	c := &WhenSchema{key: v.key, predicate: v.predicate, fn: v.fn}
+	if v.then != nil {
+		c.then = v.then.cloneSchema()
+	}
+	if v.otherwise != nil {
+		c.otherwise = v.otherwise.cloneSchema()
+	}
+	return c
+}
+
+// Returns a walkable description of the schema. See z.Describe
+func (v *WhenSchema) Describe() *SchemaDescription {
+	return Describe(v)
+}
+
+// Internal function to process the data
+func (v *WhenSchema) process(ctx *p.SchemaCtx) {
+	schema := v.resolve(ctx)
+	if schema == nil {
+		return
+	}
+	ctx.DType = schema.getType()
+	schema.process(ctx)
+}
+
+// Internal function to validate the data
+func (v *WhenSchema) validate(ctx *p.SchemaCtx) {
+	schema := v.resolve(ctx)
+	if schema == nil {
+		return
+	}
+	ctx.DType = schema.getType()
+	schema.validate(ctx)
+}
+
+// returns the schema to use for the field based on the value of the sibling field
+func (v *WhenSchema) resolve(ctx *p.SchemaCtx) ZogSchema {
+	if !ctx.Parent.IsValid() {
+		p.Panicf(p.PanicWhenDefinition, v.key, "z.When & z.WhenFunc can only be used as values of a struct shape")
+	}
+	name := strings.ToUpper(v.key[:1]) + v.key[1:]
+	field := ctx.Parent.FieldByName(name)
+	if !field.IsValid() {
+		p.Panicf(p.PanicMissingStructField, ctx.String(), name)
+	}
+	val := field.Interface()
+	if v.fn != nil {
+		return v.fn(val)
+	}
+	if v.predicate(val) {
+		return v.then
+	}
+	return v.otherwise
+}
+
+// implemented by schemas that run another schema on the same value (i.e z.Ptr or z.Lazy)
+type wrapperSchema interface {
+	unwrap() ZogSchema
+}
+
+func (v *PointerSchema) unwrap() ZogSchema          { return v.schema }
+func (v *LazySchema) unwrap() ZogSchema             { return v.resolve() }
+func (s *BoxedSchema[B, T]) unwrap() ZogSchema      { return s.schema }
+func (s *PreprocessSchema[F, T]) unwrap() ZogSchema { return s.schema }
+
+// returns true if the schema is a conditional field, including z.When wrapped in z.Ptr, z.Lazy, etc. These are executed after the other fields of a struct
+func isConditional(schema ZogSchema) bool {
+	for {
+		switch s := schema.(type) {
+		case *WhenSchema:
+			return true
+		case wrapperSchema:
+			schema = s.unwrap()
+		default:
+			return false
+		}
+	}
+}
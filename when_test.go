@@ -0,0 +1,164 @@
+package zog
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Oudwins/zog/tutils"
+	"github.com/Oudwins/zog/zconst"
+	"github.com/stretchr/testify/assert"
+)
+
+type whenCompany struct {
+	Country   string
+	VatNumber string
+}
+
+var euCountries = []string{"es", "fr", "de"}
+
+var whenCompanySchema = Struct(Shape{
+	"country": String().Required(),
+	"vatNumber": When("country", func(country string) bool {
+		return slices.Contains(euCountries, country)
+	}, String().Required().Len(9), String().Max(0)),
+})
+
+func TestWhenParse(t *testing.T) {
+	var dest whenCompany
+	errs := whenCompanySchema.Parse(map[string]any{"country": "es"}, &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeRequired, errs[0].Code)
+	assert.Equal(t, []string{"vatNumber"}, errs[0].Path)
+	assert.Equal(t, zconst.TypeString, errs[0].Dtype)
+	tutils.VerifyDefaultIssueMessages(t, errs)
+
+	dest = whenCompany{}
+	errs = whenCompanySchema.Parse(map[string]any{"country": "es", "vatNumber": "123456789"}, &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, "123456789", dest.VatNumber)
+
+	// otherwise schema
+	dest = whenCompany{}
+	errs = whenCompanySchema.Parse(map[string]any{"country": "us"}, &dest)
+	assert.Empty(t, errs)
+	errs = whenCompanySchema.Parse(map[string]any{"country": "us", "vatNumber": "123"}, &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeMax, errs[0].Code)
+	assert.Equal(t, []string{"vatNumber"}, errs[0].Path)
+}
+
+func TestWhenValidate(t *testing.T) {
+	dest := whenCompany{Country: "fr"}
+	errs := whenCompanySchema.Validate(&dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeRequired, errs[0].Code)
+	assert.Equal(t, []string{"vatNumber"}, errs[0].Path)
+
+	dest = whenCompany{Country: "us"}
+	errs = whenCompanySchema.Validate(&dest)
+	assert.Empty(t, errs)
+}
+
+func TestWhenNilSchemaSkipsField(t *testing.T) {
+	schema := Struct(Shape{
+		"country":   String(),
+		"vatNumber": When("country", func(country string) bool { return country == "es" }, String().Required(), nil),
+	})
+	var dest whenCompany
+	errs := schema.Parse(map[string]any{"country": "us", "vatNumber": "x"}, &dest)
+	assert.Empty(t, errs)
+	assert.Empty(t, dest.VatNumber)
+}
+
+func TestWhenFunc(t *testing.T) {
+	type Booking struct {
+		StartDate time.Time
+		EndDate   time.Time
+	}
+	schema := Struct(Shape{
+		"startDate": Time().Required(),
+		"endDate": WhenFunc("startDate", func(start time.Time) ZogSchema {
+			return Time().Required().After(start)
+		}),
+	})
+
+	start := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	var dest Booking
+	errs := schema.Parse(map[string]any{"startDate": start, "endDate": start.Add(-time.Hour)}, &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeAfter, errs[0].Code)
+	assert.Equal(t, []string{"endDate"}, errs[0].Path)
+	tutils.VerifyDefaultIssueMessages(t, errs)
+
+	errs = schema.Parse(map[string]any{"startDate": start, "endDate": start.Add(time.Hour)}, &dest)
+	assert.Empty(t, errs)
+
+	dest = Booking{StartDate: start, EndDate: start}
+	errs = schema.Validate(&dest, WithParallelism(4))
+	assert.Len(t, errs, 1)
+	assert.Equal(t, []string{"endDate"}, errs[0].Path)
+}
+
+func TestWhenWrapped(t *testing.T) {
+	type Company struct {
+		Country   string
+		VatNumber *string
+	}
+	// wrapped When fields also run after their siblings
+	schema := Struct(Shape{
+		"vatNumber": Ptr(Lazy(func() ZogSchema {
+			return When("country", func(country string) bool {
+				return slices.Contains(euCountries, country)
+			}, String().Len(9), String().Max(0))
+		})),
+		"country": String().Required(),
+	})
+	for _, opts := range [][]ExecOption{nil, {WithParallelism(4)}} {
+		var dest Company
+		errs := schema.Parse(map[string]any{"country": "es", "vatNumber": "123"}, &dest, opts...)
+		assert.Len(t, errs, 1)
+		assert.Equal(t, zconst.IssueCodeLen, errs[0].Code)
+		assert.Equal(t, []string{"vatNumber"}, errs[0].Path)
+
+		dest = Company{Country: "us", VatNumber: new(string)}
+		*dest.VatNumber = "123"
+		errs = schema.Validate(&dest, opts...)
+		assert.Len(t, errs, 1)
+		assert.Equal(t, zconst.IssueCodeMax, errs[0].Code)
+	}
+	assert.True(t, isConditional(schema.schema["vatNumber"]))
+}
+
+func TestWhenDescribe(t *testing.T) {
+	desc := whenCompanySchema.Describe().Shape["vatNumber"]
+	assert.Equal(t, zconst.TypeWhen, desc.Type)
+	assert.Equal(t, "country", desc.Condition)
+	assert.True(t, desc.Then.Required)
+	assert.Equal(t, zconst.TypeString, desc.Otherwise.Type)
+
+	clone := whenCompanySchema.Clone()
+	assert.NotSame(t, whenCompanySchema.schema["vatNumber"], clone.schema["vatNumber"])
+}
+
+func TestWhenPanics(t *testing.T) {
+	var dest whenCompany
+	// wrong sibling type
+	assert.Panics(t, func() {
+		Struct(Shape{
+			"country":   String(),
+			"vatNumber": When("country", func(country int) bool { return true }, String(), nil),
+		}).Parse(map[string]any{"country": "es"}, &dest)
+	})
+	// missing sibling
+	assert.Panics(t, func() {
+		Struct(Shape{
+			"vatNumber": When("missing", func(v string) bool { return true }, String(), nil),
+		}).Parse(map[string]any{}, &dest)
+	})
+	// outside of a struct
+	assert.Panics(t, func() {
+		var out []string
+		Slice(When("country", func(v string) bool { return true }, String(), nil)).Parse([]string{"a"}, &out)
+	})
+}
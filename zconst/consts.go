@@ -12,6 +12,11 @@ const (
 	// }
 	// Similar to `json` tag. But works with all input data sources at once (i.e query params, form data, json etc)
 	ZogTag = "zog"
+	// Tag used by z.FromStruct to define the rules for a field. Usage:
+	// type User struct {
+	// 	Email string `zog:"email" z:"required,email,max=255"`
+	// }
+	RulesTag = "z"
 )
 
 // Map used to format errors in Zog. Both ZogType & ZogErrCode are just strings
@@ -20,13 +25,22 @@ type LangMap = map[ZogType]map[ZogIssueCode]string
 type ZogType = string
 
 const (
-	TypeString ZogType = "string"
-	TypeNumber ZogType = "number"
-	TypeBool   ZogType = "bool"
-	TypeTime   ZogType = "time"
-	TypeSlice  ZogType = "slice"
-	TypeStruct ZogType = "struct"
-	TypePtr    ZogType = "ptr"
+	TypeString  ZogType = "string"
+	TypeNumber  ZogType = "number"
+	TypeBool    ZogType = "bool"
+	TypeTime    ZogType = "time"
+	TypeSlice   ZogType = "slice"
+	TypeStruct  ZogType = "struct"
+	TypeMap     ZogType = "map"
+	TypeUnion   ZogType = "union"
+	TypePtr     ZogType = "ptr"
+	TypeWhen    ZogType = "when"
+	TypeTuple   ZogType = "tuple"
+	TypeLiteral ZogType = "literal"
+	TypeEnum    ZogType = "enum"
+	TypeFile    ZogType = "file"
+	// issues about the execution instead of a value (i.e IssueCodeMaxIssues)
+	TypeExecution ZogType = "execution"
 )
 
 // Deprecated: This will be removed in the future. Use z.ZogIssueCode instead
@@ -165,6 +179,31 @@ const (
 	ErrCodeFalse   ZogErrCode   = "false"
 	IssueCodeFalse ZogIssueCode = "false"
 
+	// lazy only
+	IssueCodeMaxDepth ZogIssueCode = "max_depth" // recursive schema went deeper than the allowed max depth
+
+	// union only
+	IssueCodeInvalidUnion ZogIssueCode = "invalid_union" // no member of the union matched
+
+	// file only
+	IssueCodeMimeType  ZogIssueCode = "mime_type" // the type sniffed from the file content is not one of the allowed mime types
+	IssueCodeExtension ZogIssueCode = "extension" // the file name does not have one of the allowed extensions
+
+	// literal only
+	IssueCodeLiteral ZogIssueCode = "literal" // value is not the exact value of the literal schema
+
+	// discriminated union only
+	IssueCodeInvalidDiscriminator ZogIssueCode = "invalid_discriminator" // discriminator value is missing or not one of the allowed values
+
+	// struct only
+	IssueCodeUnrecognizedKeys ZogIssueCode = "unrecognized_keys" // struct strict mode found keys in the input that are not in the shape
+
+	// struct, slice & map
+	IssueCodeCancelled ZogIssueCode = "cancelled" // the context.Context passed with z.WithContext was done before the execution finished
+
+	// all
+	IssueCodeMaxIssues ZogIssueCode = "max_issues" // the execution was stopped because it found more issues than allowed by z.WithMaxIssues
+
 	// JSON
 	// Deprecated: Use IssueCodeInvalidJSON instead
 	ErrCodeInvalidJSON   ZogErrCode   = "invalid_json" // invalid json body
@@ -0,0 +1,294 @@
+package zgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"os"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	z "github.com/Oudwins/zog"
+	"github.com/Oudwins/zog/zconst"
+)
+
+// A named struct to generate from a struct schema. See Generate
+type StructDef struct {
+	// Name of the generated Go type. Must be a valid exported Go identifier
+	Name string
+	// Schema the struct is generated from
+	Schema *z.StructSchema
+	// Shape keys in the order the fields should be declared. Go maps don't keep the order of a z.Shape literal, so without it (or for keys missing from it) fields are sorted by key
+	Fields []string
+}
+
+// Generates the Go source for a file in the package with import path pkgPath (i.e github.com/me/app/models) containing one struct type per definition. The output is gofmt formatted.
+// The package clause uses the last element of the path (skipping major version suffixes like /v2). Types from pkgPath are referenced without qualifier, types from any other package are imported.
+// Each shape key becomes a field (the first letter is capitalized, same as Parse does) with `json` & `zog` tags set to the key. Fields are declared in the order of StructDef.Fields.
+// Schema types are mapped to Go types as follows:
+//   - z.String(), z.Int(), z.Bool(), z.Time() & co -> the type they parse into (i.e string, int, bool, time.Time)
+//   - z.Ptr(s) -> *T
+//   - z.Slice(s) -> []T
+//   - z.Map(k, v) -> map[K]V
+//   - z.Struct(s) -> the name of the definition using that same schema or an anonymous struct otherwise
+//   - z.Boxed, z.Preprocess & z.CustomFunc -> the type they write into the destination
+//   - z.When -> the type of the then schema
+//   - z.Union, z.DiscriminatedUnion, z.WhenFunc & z.Use -> any
+//
+// It is meant to be used from a small program called by `go generate`. Usage:
+//
+//	//go:generate go run ./gen
+//	func main() {
+//		err := zgen.Write("models_gen.go", "github.com/me/app/models", zgen.StructDef{Name: "User", Schema: userSchema, Fields: []string{"id", "name"}})
+//	}
+func Generate(pkgPath string, defs ...StructDef) ([]byte, error) {
+	pkgName := packageName(pkgPath)
+	if !token.IsIdentifier(pkgName) {
+		return nil, fmt.Errorf("zgen: invalid package path %q. Its last element must be a valid package name", pkgPath)
+	}
+	schemas := make([]z.ZogSchema, len(defs))
+	for i, def := range defs {
+		if !token.IsIdentifier(def.Name) || !token.IsExported(def.Name) {
+			return nil, fmt.Errorf("zgen: invalid struct name %q. It must be an exported Go identifier", def.Name)
+		}
+		if def.Schema == nil {
+			return nil, fmt.Errorf("zgen: struct definition %s has a nil schema", def.Name)
+		}
+		schemas[i] = def.Schema
+	}
+	// described together so nested definitions map to the same description & can be referenced by name
+	descs := z.DescribeAll(schemas...)
+	g := &generator{
+		pkgPath:  pkgPath,
+		names:    make(map[*z.SchemaDescription]string, len(defs)),
+		imports:  map[string]bool{},
+		visiting: map[*z.SchemaDescription]bool{},
+	}
+	for i, def := range defs {
+		g.names[descs[i]] = def.Name
+	}
+
+	var body bytes.Buffer
+	for i, def := range defs {
+		fmt.Fprintf(&body, "type %s ", def.Name)
+		if err := g.writeStruct(&body, descs[i], def.Fields); err != nil {
+			return nil, fmt.Errorf("zgen: generating %s: %w", def.Name, err)
+		}
+		body.WriteString("\n\n")
+	}
+
+	var out bytes.Buffer
+	out.WriteString("// Code generated by zgen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&out, "package %s\n\n", pkgName)
+	if len(g.imports) > 0 {
+		imports := make([]string, 0, len(g.imports))
+		for imp := range g.imports {
+			imports = append(imports, imp)
+		}
+		sort.Strings(imports)
+		out.WriteString("import (\n")
+		for _, imp := range imports {
+			fmt.Fprintf(&out, "%q\n", imp)
+		}
+		out.WriteString(")\n\n")
+	}
+	out.Write(body.Bytes())
+
+	return format.Source(out.Bytes())
+}
+
+// Same as Generate but writes the result to the file at path
+func Write(path string, pkgPath string, defs ...StructDef) error {
+	src, err := Generate(pkgPath, defs...)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, src, 0o644)
+}
+
+// matches the major version suffix of module paths. i.e v2
+var majorVersion = regexp.MustCompile(`^v[0-9]+$`)
+
+// returns the default name of the package with the import path. The last element, or the one before it for major version suffixes
+func packageName(pkgPath string) string {
+	parts := strings.Split(pkgPath, "/")
+	name := parts[len(parts)-1]
+	if len(parts) > 1 && majorVersion.MatchString(name) {
+		name = parts[len(parts)-2]
+	}
+	return name
+}
+
+type generator struct {
+	pkgPath  string
+	names    map[*z.SchemaDescription]string
+	imports  map[string]bool
+	visiting map[*z.SchemaDescription]bool
+}
+
+// writes the struct literal type for the description. Fields follow order & the keys missing from it are sorted so the output is stable
+func (g *generator) writeStruct(buf *bytes.Buffer, desc *z.SchemaDescription, order []string) error {
+	if g.visiting[desc] {
+		return fmt.Errorf("recursive struct schemas must be generated as a named StructDef")
+	}
+	g.visiting[desc] = true
+	defer delete(g.visiting, desc)
+
+	keys, err := fieldKeys(desc.Shape, order)
+	if err != nil {
+		return err
+	}
+	buf.WriteString("struct {\n")
+	for _, key := range keys {
+		if key == "" {
+			return fmt.Errorf("shape keys cannot be empty")
+		}
+		name := strings.ToUpper(key[:1]) + key[1:]
+		if !token.IsIdentifier(name) {
+			return fmt.Errorf("shape key %q is not a valid Go field name", key)
+		}
+		fieldType, err := g.goType(desc.Shape[key])
+		if err != nil {
+			return fmt.Errorf("field %s: %w", key, err)
+		}
+		fmt.Fprintf(buf, "%s %s `json:%q %s:%q`\n", name, fieldType, key, zconst.ZogTag, key)
+	}
+	buf.WriteString("}")
+	return nil
+}
+
+// returns the shape keys in order followed by the rest of the keys sorted
+func fieldKeys(shape map[string]*z.SchemaDescription, order []string) ([]string, error) {
+	keys := make([]string, 0, len(shape))
+	listed := make(map[string]bool, len(order))
+	for _, key := range order {
+		if _, ok := shape[key]; !ok {
+			return nil, fmt.Errorf("field %q is not in the shape", key)
+		}
+		if listed[key] {
+			return nil, fmt.Errorf("field %q is listed more than once", key)
+		}
+		listed[key] = true
+		keys = append(keys, key)
+	}
+	rest := make([]string, 0, len(shape)-len(keys))
+	for key := range shape {
+		if !listed[key] {
+			rest = append(rest, key)
+		}
+	}
+	sort.Strings(rest)
+	return append(keys, rest...), nil
+}
+
+// returns the Go type expression for the description. Struct descriptions that have a definition are referenced by name
+func (g *generator) goType(desc *z.SchemaDescription) (string, error) {
+	if desc.BoxType != nil {
+		return g.reflectType(desc.BoxType), nil
+	}
+	switch desc.Type {
+	case zconst.TypeStruct:
+		if name, ok := g.names[desc]; ok {
+			return name, nil
+		}
+		var buf bytes.Buffer
+		if err := g.writeStruct(&buf, desc, nil); err != nil {
+			return "", err
+		}
+		return buf.String(), nil
+	case zconst.TypePtr:
+		t, err := g.goType(desc.Schema)
+		return "*" + t, err
+	case zconst.TypeSlice:
+		t, err := g.goType(desc.Schema)
+		return "[]" + t, err
+	case zconst.TypeMap:
+		k, err := g.goType(desc.Key)
+		if err != nil {
+			return "", err
+		}
+		v, err := g.goType(desc.Schema)
+		return fmt.Sprintf("map[%s]%s", k, v), err
+	case zconst.TypeTuple:
+		return g.tupleType(desc)
+	case zconst.TypeWhen:
+		if desc.Then == nil {
+			return "any", nil
+		}
+		return g.goType(desc.Then)
+	default:
+		return g.reflectType(desc.GoType), nil
+	}
+}
+
+// returns an array (or a slice if there is a rest schema) when all items have the same type. Otherwise a struct with one field per position
+func (g *generator) tupleType(desc *z.SchemaDescription) (string, error) {
+	items := make([]string, len(desc.Items))
+	same := true
+	for i, item := range desc.Items {
+		t, err := g.goType(item)
+		if err != nil {
+			return "", err
+		}
+		items[i] = t
+		same = same && t == items[0]
+	}
+	rest := ""
+	if desc.Schema != nil {
+		t, err := g.goType(desc.Schema)
+		if err != nil {
+			return "", err
+		}
+		rest = t
+		same = same && (len(items) == 0 || t == items[0])
+	}
+	if same && rest != "" {
+		return "[]" + rest, nil
+	}
+	if same && len(items) > 0 {
+		return fmt.Sprintf("[%d]%s", len(items), items[0]), nil
+	}
+	var b strings.Builder
+	b.WriteString("struct {\n")
+	for i, t := range items {
+		fmt.Fprintf(&b, "Item%d %s\n", i, t)
+	}
+	if rest != "" {
+		fmt.Fprintf(&b, "Rest []%s\n", rest)
+	}
+	b.WriteString("}")
+	return b.String(), nil
+}
+
+// returns the Go type expression for a reflect type and records the imports it needs. nil (i.e unions) is any
+func (g *generator) reflectType(t reflect.Type) string {
+	if t == nil {
+		return "any"
+	}
+	if t.PkgPath() != "" {
+		// types from the package being generated are referenced without qualifier. Compared by import path since different packages can share a name
+		if t.PkgPath() == g.pkgPath {
+			return t.Name()
+		}
+		g.imports[t.PkgPath()] = true
+		return t.String()
+	}
+	switch t.Kind() {
+	case reflect.Pointer:
+		return "*" + g.reflectType(t.Elem())
+	case reflect.Slice:
+		return "[]" + g.reflectType(t.Elem())
+	case reflect.Array:
+		return fmt.Sprintf("[%d]%s", t.Len(), g.reflectType(t.Elem()))
+	case reflect.Map:
+		return fmt.Sprintf("map[%s]%s", g.reflectType(t.Key()), g.reflectType(t.Elem()))
+	case reflect.Interface:
+		if t.NumMethod() == 0 {
+			return "any"
+		}
+	}
+	return t.String()
+}
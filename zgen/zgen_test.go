@@ -0,0 +1,165 @@
+package zgen
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	z "github.com/Oudwins/zog"
+	"github.com/stretchr/testify/assert"
+)
+
+type genStatus string
+
+func TestGenerate(t *testing.T) {
+	addressSchema := z.Struct(z.Shape{
+		"street": z.String().Required(),
+		"zip":    z.Int(),
+	})
+	userSchema := z.Struct(z.Shape{
+		"name":      z.String().Required(),
+		"age":       z.Int64(),
+		"active":    z.Bool(),
+		"createdAt": z.Time(),
+		"tags":      z.Slice(z.String()),
+		"address":   z.Ptr(addressSchema),
+		"meta":      z.Map(z.String(), z.Float64()),
+		"settings": z.Struct(z.Shape{
+			"theme": z.String(),
+		}),
+	})
+
+	src, err := Generate("models",
+		StructDef{Name: "Address", Schema: addressSchema},
+		StructDef{Name: "User", Schema: userSchema, Fields: []string{"name", "age", "active", "createdAt", "tags", "address", "meta", "settings"}},
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, `// Code generated by zgen. DO NOT EDIT.
+
+package models
+
+import (
+	"time"
+)
+
+type Address struct {
+	Street string `+"`json:\"street\" zog:\"street\"`"+`
+	Zip    int    `+"`json:\"zip\" zog:\"zip\"`"+`
+}
+
+type User struct {
+	Name      string             `+"`json:\"name\" zog:\"name\"`"+`
+	Age       int64              `+"`json:\"age\" zog:\"age\"`"+`
+	Active    bool               `+"`json:\"active\" zog:\"active\"`"+`
+	CreatedAt time.Time          `+"`json:\"createdAt\" zog:\"createdAt\"`"+`
+	Tags      []string           `+"`json:\"tags\" zog:\"tags\"`"+`
+	Address   *Address           `+"`json:\"address\" zog:\"address\"`"+`
+	Meta      map[string]float64 `+"`json:\"meta\" zog:\"meta\"`"+`
+	Settings  struct {
+		Theme string `+"`json:\"theme\" zog:\"theme\"`"+`
+	} `+"`json:\"settings\" zog:\"settings\"`"+`
+}
+`, string(src))
+}
+
+func TestGenerateFieldOrder(t *testing.T) {
+	schema := z.Struct(z.Shape{
+		"id":    z.String(),
+		"name":  z.String(),
+		"email": z.String(),
+		"age":   z.Int(),
+	})
+	// keys missing from Fields go last sorted
+	src, err := Generate("models", StructDef{Name: "User", Schema: schema, Fields: []string{"name", "id"}})
+	assert.Nil(t, err)
+	assert.Regexp(t, `(?s)Name .*Id .*Age .*Email `, string(src))
+
+	_, err = Generate("models", StructDef{Name: "User", Schema: schema, Fields: []string{"phone"}})
+	assert.Error(t, err)
+	_, err = Generate("models", StructDef{Name: "User", Schema: schema, Fields: []string{"id", "id"}})
+	assert.Error(t, err)
+}
+
+func TestGenerateCustomTypes(t *testing.T) {
+	schema := z.Struct(z.Shape{
+		"status": z.StringLike[genStatus](),
+		"any":    z.Union(z.String(), z.Int()),
+		"nick": z.Boxed(z.String(), func(b sql.NullString, ctx z.Ctx) (string, error) { return b.String, nil },
+			func(s string, ctx z.Ctx) (sql.NullString, error) { return sql.NullString{String: s, Valid: true}, nil }),
+	})
+	src, err := Generate("github.com/Oudwins/zog/zgen", StructDef{Name: "Thing", Schema: schema, Fields: []string{"status", "any", "nick"}})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), `"database/sql"`)
+	assert.Contains(t, string(src), "Status genStatus")
+	assert.Contains(t, string(src), "Any    any")
+	assert.Contains(t, string(src), "Nick   sql.NullString")
+}
+
+func TestGenerateTuples(t *testing.T) {
+	schema := z.Struct(z.Shape{
+		"point": z.Tuple(z.Float64(), z.Float64()),
+		"pair":  z.Tuple(z.String(), z.Int()),
+		"path":  z.Tuple(z.String()).Rest(z.String()),
+	})
+	src, err := Generate("models", StructDef{Name: "Thing", Schema: schema})
+	assert.Nil(t, err)
+	assert.Regexp(t, `Point +\[2\]float64`, string(src))
+	assert.Regexp(t, `Path +\[\]string`, string(src))
+	assert.Regexp(t, `Pair +struct \{\s+Item0 string\s+Item1 int\s+\}`, string(src))
+}
+
+func TestGenerateRecursive(t *testing.T) {
+	var comment *z.StructSchema
+	comment = z.Struct(z.Shape{
+		"text":    z.String(),
+		"replies": z.Slice(z.Lazy(func() z.ZogSchema { return comment })),
+	})
+	src, err := Generate("models", StructDef{Name: "Comment", Schema: comment})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "Replies []Comment")
+
+	anon := z.Struct(z.Shape{"comment": comment})
+	_, err = Generate("models", StructDef{Name: "Post", Schema: anon})
+	assert.Error(t, err)
+}
+
+func TestGenerateSamePackageNameDifferentPath(t *testing.T) {
+	schema := z.Struct(z.Shape{
+		"nick": z.Boxed(z.String(), func(b sql.NullString, ctx z.Ctx) (string, error) { return b.String, nil },
+			func(s string, ctx z.Ctx) (sql.NullString, error) { return sql.NullString{String: s, Valid: true}, nil }),
+	})
+	// the generated package is also named sql but it is not database/sql so the type is imported
+	src, err := Generate("example.com/app/sql", StructDef{Name: "Thing", Schema: schema})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "package sql")
+	assert.Contains(t, string(src), `"database/sql"`)
+	assert.Contains(t, string(src), "Nick sql.NullString")
+}
+
+func TestGeneratePackageName(t *testing.T) {
+	src, err := Generate("example.com/app/models/v2", StructDef{Name: "User", Schema: z.Struct(z.Shape{})})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "package models")
+	src, err = Generate("v2", StructDef{Name: "User", Schema: z.Struct(z.Shape{})})
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "package v2")
+	_, err = Generate("example.com/app/my-models", StructDef{Name: "User", Schema: z.Struct(z.Shape{})})
+	assert.Error(t, err)
+}
+
+func TestGenerateInvalid(t *testing.T) {
+	_, err := Generate("models", StructDef{Name: "user", Schema: z.Struct(z.Shape{})})
+	assert.Error(t, err)
+	_, err = Generate("models", StructDef{Name: "User", Schema: z.Struct(z.Shape{"first-name": z.String()})})
+	assert.Error(t, err)
+}
+
+func TestWrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "models_gen.go")
+	err := Write(path, "models", StructDef{Name: "User", Schema: z.Struct(z.Shape{"name": z.String()})})
+	assert.Nil(t, err)
+	src, err := os.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Contains(t, string(src), "type User struct")
+}
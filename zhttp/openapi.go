@@ -0,0 +1,392 @@
+package zhttp
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	// the OpenAPI generation lives next to the parsers so params & bodies are named after the same struct tags. zhttp users already import zog to build the schemas they parse with & zjsonschema only depends on zog, so neither adds dependencies
+	z "github.com/Oudwins/zog"
+	"github.com/Oudwins/zog/zjsonschema"
+)
+
+// OpenAPI version of the documents generated by Registry.OpenAPI
+const OpenAPIVersion = "3.1.0"
+
+// Name of the shared 400 response & issue schema added to the components of the generated documents
+const IssuesComponentName = "ZogIssues"
+
+// An input of a route. Schema is the zog schema used to parse it & Dest is a value or pointer of the type it is parsed into.
+// Dest is used to name params & properties after the struct tags (`path`, `query`, `form` or `json`) the same way parsing does. If Dest is nil the shape keys are used
+type Input struct {
+	Schema z.ZogSchema
+	Dest   any
+}
+
+// A route parsed with zhttp. Used to generate the OpenAPI operation for it
+type Route struct {
+	// HTTP method, i.e GET
+	Method string
+	// OpenAPI path. i.e /users/{id}
+	Path        string
+	OperationID string
+	Summary     string
+	Description string
+	Tags        []string
+	// Path params. Must be a struct schema, each field becomes a required parameter named after the `path` tag. Wildcards in Path without a field are documented as strings
+	PathParams *Input
+	// Query params. Must be a struct schema, each field becomes a parameter
+	Query *Input
+	// Form body (application/x-www-form-urlencoded)
+	Form *Input
+	// JSON body (application/json)
+	JSON *Input
+	// Responses keyed by status code. If empty a generic 200 response is added. A 400 response with the zog issues is always added to routes with inputs
+	Responses map[string]*Response
+}
+
+// Collects the routes of an API to generate its OpenAPI document. Usage:
+//
+//	registry := zhttp.NewRegistry()
+//	registry.Add(zhttp.Route{
+//		Method: "POST",
+//		Path:   "/users",
+//		JSON:   &zhttp.Input{Schema: userSchema, Dest: User{}},
+//	})
+//	doc := registry.OpenAPI(zhttp.Info{Title: "My API", Version: "1.0.0"})
+type Registry struct {
+	routes []Route
+}
+
+// Creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Adds a route to the registry
+func (r *Registry) Add(route Route) *Registry {
+	r.routes = append(r.routes, route)
+	return r
+}
+
+// Generates the OpenAPI 3.1 document for all the routes in the registry. Marshal it with encoding/json
+func (r *Registry) OpenAPI(info Info) *OpenAPI {
+	doc := &OpenAPI{
+		OpenAPI: OpenAPIVersion,
+		Info:    info,
+		Paths:   map[string]PathItem{},
+	}
+	for _, route := range r.routes {
+		op := route.operation(doc)
+		item, ok := doc.Paths[route.Path]
+		if !ok {
+			item = PathItem{}
+			doc.Paths[route.Path] = item
+		}
+		item[strings.ToLower(route.Method)] = op
+	}
+	return doc
+}
+
+func (route Route) hasInputs() bool {
+	return route.PathParams != nil || route.Query != nil || route.Form != nil || route.JSON != nil
+}
+
+// returns the operation for the route. The schemas of its inputs & the 400 response are added to the components of doc
+func (route Route) operation(doc *OpenAPI) *Operation {
+	op := &Operation{
+		OperationID: route.OperationID,
+		Summary:     route.Summary,
+		Description: route.Description,
+		Tags:        route.Tags,
+		Responses:   map[string]*Response{},
+	}
+	for code, res := range route.Responses {
+		op.Responses[code] = res
+	}
+	if len(op.Responses) == 0 {
+		op.Responses["200"] = &Response{Description: "Successful response"}
+	}
+	if route.hasInputs() {
+		// added before the input schemas so they can't take its name
+		doc.components().addIssues()
+		op.Responses["400"] = &Response{Ref: "#/components/responses/" + IssuesComponentName}
+	}
+
+	op.Parameters = pathParameters(doc, route.Path, route.PathParams)
+	if route.Query != nil {
+		op.Parameters = append(op.Parameters, queryParameters(doc, route.Query)...)
+	}
+	if route.Form != nil || route.JSON != nil {
+		components := doc.components()
+		op.RequestBody = &RequestBody{Required: true, Content: map[string]*MediaType{}}
+		if route.Form != nil {
+			op.RequestBody.Content["application/x-www-form-urlencoded"] = &MediaType{Schema: components.addSchema(route.Form.name("Form"), route.Form.jsonSchema(formTag))}
+		}
+		if route.JSON != nil {
+			op.RequestBody.Content["application/json"] = &MediaType{Schema: components.addSchema(route.JSON.name("Body"), route.JSON.jsonSchema(jsonTag))}
+		}
+	}
+	return op
+}
+
+// returns the json schema for the input without the $schema keyword since OpenAPI 3.1 already uses the same dialect
+func (i *Input) jsonSchema(tag string) *zjsonschema.Schema {
+	var s *zjsonschema.Schema
+	if i.Dest != nil {
+		s = zjsonschema.From(i.Schema, zjsonschema.WithStructTags(i.Dest, tag))
+	} else {
+		s = zjsonschema.From(i.Schema)
+	}
+	s.Schema = ""
+	return s
+}
+
+// matches the characters that are not allowed in component names
+var invalidComponentChars = regexp.MustCompile(`[^a-zA-Z0-9._-]`)
+
+// returns the base name of the components created for the input. The name of the Dest type or fallback if it has none
+func (i *Input) name(fallback string) string {
+	typ := reflect.TypeOf(i.Dest)
+	for typ != nil && typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	if typ == nil || typ.Name() == "" {
+		return fallback
+	}
+	return invalidComponentChars.ReplaceAllString(typ.Name(), "_")
+}
+
+// matches the wildcards of an OpenAPI path. i.e {id}
+var pathWildcard = regexp.MustCompile(`\{([^{}]+)\}`)
+
+// every wildcard of the path becomes a required path parameter. Its schema is the property of the input with the same name or a string if there is none. Fields that are not in the path are skipped since OpenAPI only allows params for the path wildcards
+func pathParameters(doc *OpenAPI, path string, input *Input) []*Parameter {
+	var props map[string]*zjsonschema.Schema
+	if input != nil {
+		s := input.jsonSchema(pathTag)
+		doc.components().addSchema(input.name("Path"), s)
+		props = s.Properties
+	}
+	var params []*Parameter
+	seen := map[string]bool{}
+	for _, match := range pathWildcard.FindAllStringSubmatch(path, -1) {
+		name := match[1]
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		schema, ok := props[name]
+		if !ok {
+			schema = &zjsonschema.Schema{Type: "string"}
+		}
+		params = append(params, &Parameter{Name: name, In: "path", Required: true, Schema: schema})
+	}
+	return params
+}
+
+// every property of the query schema becomes a query parameter
+func queryParameters(doc *OpenAPI, input *Input) []*Parameter {
+	s := input.jsonSchema(queryParam)
+	doc.components().addSchema(input.name("Query"), s)
+	required := make(map[string]bool, len(s.Required))
+	for _, name := range s.Required {
+		required[name] = true
+	}
+	names := sortedKeys(s.Properties)
+	params := make([]*Parameter, 0, len(names))
+	for _, name := range names {
+		params = append(params, &Parameter{
+			Name:     name,
+			In:       "query",
+			Required: required[name],
+			Schema:   s.Properties[name],
+		})
+	}
+	return params
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// returns the components of the document. They are created the first time a route needs them
+func (doc *OpenAPI) components() *Components {
+	if doc.Components == nil {
+		doc.Components = &Components{
+			Schemas:   map[string]*zjsonschema.Schema{},
+			Responses: map[string]*Response{},
+		}
+	}
+	return doc.Components
+}
+
+// Moves the $defs of a schema generated by zjsonschema into the components & rewrites its refs to point to them, since refs in an OpenAPI document resolve against the document root.
+// If the schema references itself ($ref "#") the root is added to the components too & a ref to it is returned. Otherwise the schema is returned to be embedded
+func (c *Components) addSchema(base string, s *zjsonschema.Schema) *zjsonschema.Schema {
+	refs := make(map[string]string, len(s.Defs))
+	defs := s.Defs
+	s.Defs = nil
+	for _, name := range sortedKeys(defs) {
+		component := c.uniqueName(base + "_" + name)
+		c.Schemas[component] = defs[name]
+		refs["#/$defs/"+name] = "#/components/schemas/" + component
+	}
+
+	rootRef := ""
+	rewrite := func(schema *zjsonschema.Schema) {
+		if schema.Ref == "#" {
+			if rootRef == "" {
+				component := c.uniqueName(base)
+				c.Schemas[component] = s
+				rootRef = "#/components/schemas/" + component
+			}
+			schema.Ref = rootRef
+		} else if ref, ok := refs[schema.Ref]; ok {
+			schema.Ref = ref
+		}
+	}
+	walkSchema(s, rewrite)
+	for _, def := range defs {
+		walkSchema(def, rewrite)
+	}
+
+	if rootRef != "" {
+		return &zjsonschema.Schema{Ref: rootRef}
+	}
+	return s
+}
+
+// returns name or name followed by the first number that makes it unique in the component schemas
+func (c *Components) uniqueName(name string) string {
+	if _, ok := c.Schemas[name]; !ok {
+		return name
+	}
+	for i := 2; ; i++ {
+		candidate := fmt.Sprintf("%s%d", name, i)
+		if _, ok := c.Schemas[candidate]; !ok {
+			return candidate
+		}
+	}
+}
+
+// calls fn for the schema & every schema nested in it
+func walkSchema(s *zjsonschema.Schema, fn func(s *zjsonschema.Schema)) {
+	if s == nil {
+		return
+	}
+	fn(s)
+	for _, def := range s.Defs {
+		walkSchema(def, fn)
+	}
+	for _, item := range s.PrefixItems {
+		walkSchema(item, fn)
+	}
+	walkSchema(s.Items, fn)
+	walkSchema(s.Contains, fn)
+	for _, prop := range s.Properties {
+		walkSchema(prop, fn)
+	}
+	walkSchema(s.PropertyNames, fn)
+	walkSchema(s.AdditionalProperties, fn)
+	for _, sub := range s.AllOf {
+		walkSchema(sub, fn)
+	}
+	for _, sub := range s.AnyOf {
+		walkSchema(sub, fn)
+	}
+	for _, sub := range s.OneOf {
+		walkSchema(sub, fn)
+	}
+}
+
+// adds the 400 response returned with the list of zog issues & the schema for a single issue
+func (c *Components) addIssues() {
+	if _, ok := c.Responses[IssuesComponentName]; ok {
+		return
+	}
+	str := &zjsonschema.Schema{Type: "string"}
+	c.Schemas[IssuesComponentName] = &zjsonschema.Schema{
+		Type: "array",
+		Items: &zjsonschema.Schema{
+			Type: "object",
+			Properties: map[string]*zjsonschema.Schema{
+				"Code":    str,
+				"Path":    {Type: "array", Items: str},
+				"Value":   {},
+				"Dtype":   str,
+				"Params":  {Type: "object"},
+				"Message": str,
+			},
+			Required: []string{"Code", "Message"},
+		},
+	}
+	c.Responses[IssuesComponentName] = &Response{
+		Description: "The request failed validation. The body contains the list of issues",
+		Content: map[string]*MediaType{
+			"application/json": {Schema: &zjsonschema.Schema{Ref: "#/components/schemas/" + IssuesComponentName}},
+		},
+	}
+}
+
+// ! OpenAPI document types. Only the fields used by the generated documents are included
+
+type OpenAPI struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       Info                `json:"info"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components *Components         `json:"components,omitempty"`
+}
+
+type Info struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+// Operations of a path keyed by the lowercase method
+type PathItem map[string]*Operation
+
+type Operation struct {
+	OperationID string               `json:"operationId,omitempty"`
+	Summary     string               `json:"summary,omitempty"`
+	Description string               `json:"description,omitempty"`
+	Tags        []string             `json:"tags,omitempty"`
+	Parameters  []*Parameter         `json:"parameters,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+type Parameter struct {
+	Name     string              `json:"name"`
+	In       string              `json:"in"`
+	Required bool                `json:"required,omitempty"`
+	Schema   *zjsonschema.Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Required bool                  `json:"required,omitempty"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+type MediaType struct {
+	Schema *zjsonschema.Schema `json:"schema"`
+}
+
+type Response struct {
+	Ref         string                `json:"$ref,omitempty"`
+	Description string                `json:"description,omitempty"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+type Components struct {
+	Schemas   map[string]*zjsonschema.Schema `json:"schemas,omitempty"`
+	Responses map[string]*Response           `json:"responses,omitempty"`
+}
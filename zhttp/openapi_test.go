@@ -0,0 +1,251 @@
+package zhttp
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	z "github.com/Oudwins/zog"
+	"github.com/Oudwins/zog/zjsonschema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenAPIDocument(t *testing.T) {
+	type ListQuery struct {
+		Page  int    `query:"page"`
+		Order string `query:"order_by"`
+	}
+	type CreateUser struct {
+		Name  string `json:"name"`
+		Email string `json:"email_address"`
+	}
+	registry := NewRegistry().
+		Add(Route{
+			Method:      "GET",
+			Path:        "/users",
+			OperationID: "listUsers",
+			Query: &Input{
+				Schema: z.Struct(z.Shape{
+					"page":  z.Int().GTE(1).Required(),
+					"order": z.String().OneOf([]string{"asc", "desc"}),
+				}),
+				Dest: ListQuery{},
+			},
+		}).
+		Add(Route{
+			Method:      "POST",
+			Path:        "/users",
+			OperationID: "createUser",
+			Tags:        []string{"users"},
+			JSON: &Input{
+				Schema: z.Struct(z.Shape{
+					"name":  z.String().Required(),
+					"email": z.String().Email(),
+				}),
+				Dest: &CreateUser{},
+			},
+			Responses: map[string]*Response{"201": {Description: "Created"}},
+		}).
+		Add(Route{
+			Method: "GET",
+			Path:   "/health",
+		})
+
+	b, err := json.Marshal(registry.OpenAPI(Info{Title: "Users", Version: "1.0.0"}))
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{
+		"openapi": "3.1.0",
+		"info": {"title": "Users", "version": "1.0.0"},
+		"paths": {
+			"/health": {
+				"get": {"responses": {"200": {"description": "Successful response"}}}
+			},
+			"/users": {
+				"get": {
+					"operationId": "listUsers",
+					"parameters": [
+						{"name": "order_by", "in": "query", "schema": {"type": "string", "enum": ["asc", "desc"]}},
+						{"name": "page", "in": "query", "required": true, "schema": {"type": "integer", "minimum": 1}}
+					],
+					"responses": {
+						"200": {"description": "Successful response"},
+						"400": {"$ref": "#/components/responses/ZogIssues"}
+					}
+				},
+				"post": {
+					"operationId": "createUser",
+					"tags": ["users"],
+					"requestBody": {
+						"required": true,
+						"content": {
+							"application/json": {
+								"schema": {
+									"type": "object",
+									"properties": {
+										"email_address": {"type": "string", "format": "email"},
+										"name": {"type": "string"}
+									},
+									"required": ["name"]
+								}
+							}
+						}
+					},
+					"responses": {
+						"201": {"description": "Created"},
+						"400": {"$ref": "#/components/responses/ZogIssues"}
+					}
+				}
+			}
+		},
+		"components": {
+			"schemas": {
+				"ZogIssues": {
+					"type": "array",
+					"items": {
+						"type": "object",
+						"properties": {
+							"Code": {"type": "string"},
+							"Path": {"type": "array", "items": {"type": "string"}},
+							"Value": {},
+							"Dtype": {"type": "string"},
+							"Params": {"type": "object"},
+							"Message": {"type": "string"}
+						},
+						"required": ["Code", "Message"]
+					}
+				}
+			},
+			"responses": {
+				"ZogIssues": {
+					"description": "The request failed validation. The body contains the list of issues",
+					"content": {"application/json": {"schema": {"$ref": "#/components/schemas/ZogIssues"}}}
+				}
+			}
+		}
+	}`, string(b))
+}
+
+func TestOpenAPIFormBodyWithoutDest(t *testing.T) {
+	registry := NewRegistry().Add(Route{
+		Method: "POST",
+		Path:   "/login",
+		Form:   &Input{Schema: z.Struct(z.Shape{"username": z.String().Required()})},
+	})
+	doc := registry.OpenAPI(Info{Title: "Auth", Version: "1"})
+	body := doc.Paths["/login"]["post"].RequestBody
+	schema := body.Content["application/x-www-form-urlencoded"].Schema
+	assert.Equal(t, "", schema.Schema)
+	assert.Contains(t, schema.Properties, "username")
+	assert.Equal(t, []string{"username"}, schema.Required)
+}
+
+func TestOpenAPIPathParams(t *testing.T) {
+	type GetUser struct {
+		OrgID  string `path:"orgID"`
+		UserID int    `path:"id"`
+	}
+	registry := NewRegistry().
+		Add(Route{
+			Method: "GET",
+			Path:   "/orgs/{orgID}/users/{id}",
+			PathParams: &Input{
+				Schema: z.Struct(z.Shape{
+					"orgID":  z.String(),
+					"userID": z.Int().GT(0),
+				}),
+				Dest: GetUser{},
+			},
+			Query: &Input{Schema: z.Struct(z.Shape{"expand": z.Bool()})},
+		}).
+		Add(Route{Method: "DELETE", Path: "/sessions/{token}"})
+
+	doc := registry.OpenAPI(Info{Title: "Users", Version: "1"})
+	b, err := json.Marshal(doc.Paths["/orgs/{orgID}/users/{id}"]["get"].Parameters)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `[
+		{"name": "orgID", "in": "path", "required": true, "schema": {"type": "string"}},
+		{"name": "id", "in": "path", "required": true, "schema": {"type": "integer", "exclusiveMinimum": 0}},
+		{"name": "expand", "in": "query", "schema": {"type": "boolean"}}
+	]`, string(b))
+
+	// wildcards without a schema are still documented. The route has no inputs so there is no 400 response
+	op := doc.Paths["/sessions/{token}"]["delete"]
+	assert.Equal(t, []*Parameter{{Name: "token", In: "path", Required: true, Schema: &zjsonschema.Schema{Type: "string"}}}, op.Parameters)
+	assert.NotContains(t, op.Responses, "400")
+}
+
+func TestOpenAPIRecursiveSchemas(t *testing.T) {
+	type Comment struct {
+		Text    string    `json:"text"`
+		Replies []Comment `json:"replies"`
+	}
+	var comment *z.StructSchema
+	comment = z.Struct(z.Shape{
+		"text":    z.String().Required(),
+		"replies": z.Slice(z.Lazy(func() z.ZogSchema { return comment })),
+	})
+	var filter *z.StructSchema
+	filter = z.Struct(z.Shape{
+		"field": z.String(),
+		"and":   z.Slice(z.Lazy(func() z.ZogSchema { return filter })),
+	})
+	registry := NewRegistry().
+		Add(Route{Method: "POST", Path: "/comments", JSON: &Input{Schema: comment, Dest: Comment{}}}).
+		Add(Route{Method: "GET", Path: "/comments", Query: &Input{Schema: z.Struct(z.Shape{"filter": filter})}}).
+		Add(Route{Method: "PUT", Path: "/comments/{id}", JSON: &Input{Schema: comment, Dest: &Comment{}}})
+
+	doc := registry.OpenAPI(Info{Title: "Comments", Version: "1"})
+	b, err := json.Marshal(doc)
+	assert.Nil(t, err)
+	var raw map[string]any
+	assert.Nil(t, json.Unmarshal(b, &raw))
+
+	// every route keeps the components added by the previous ones
+	assert.Contains(t, doc.Components.Schemas, IssuesComponentName)
+	assert.Equal(t, &zjsonschema.Schema{Ref: "#/components/schemas/Comment"}, doc.Paths["/comments"]["post"].RequestBody.Content["application/json"].Schema)
+	assert.Equal(t, &zjsonschema.Schema{Ref: "#/components/schemas/Comment2"}, doc.Paths["/comments/{id}"]["put"].RequestBody.Content["application/json"].Schema)
+	assert.Equal(t, "#/components/schemas/Query_schema1", doc.Paths["/comments"]["get"].Parameters[0].Schema.Ref)
+
+	refs := collectRefs(raw)
+	assert.NotEmpty(t, refs)
+	for _, ref := range refs {
+		assert.NotNil(t, resolveRef(raw, ref), "unresolved ref %s", ref)
+	}
+}
+
+// returns the value of every $ref in the document
+func collectRefs(v any) []string {
+	var refs []string
+	switch v := v.(type) {
+	case map[string]any:
+		for k, child := range v {
+			if ref, ok := child.(string); ok && k == "$ref" {
+				refs = append(refs, ref)
+				continue
+			}
+			refs = append(refs, collectRefs(child)...)
+		}
+	case []any:
+		for _, child := range v {
+			refs = append(refs, collectRefs(child)...)
+		}
+	}
+	return refs
+}
+
+// resolves a local ref against the document root. Returns nil if it points nowhere
+func resolveRef(doc map[string]any, ref string) any {
+	if !strings.HasPrefix(ref, "#/") {
+		return nil
+	}
+	var cur any = doc
+	for _, part := range strings.Split(ref[2:], "/") {
+		part = strings.ReplaceAll(strings.ReplaceAll(part, "~1", "/"), "~0", "~")
+		m, ok := cur.(map[string]any)
+		if !ok {
+			return nil
+		}
+		cur = m[part]
+	}
+	return cur
+}
@@ -2,6 +2,7 @@ package zhttp
 
 import (
 	"errors"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 	"reflect"
@@ -17,6 +18,10 @@ type ParserFunc = func(r *http.Request) p.DpFactory
 var (
 	formTag    string = "form"
 	queryParam string = "query"
+	jsonTag    string = "json"
+	pathTag    string = "path"
+	headerTag  string = "header"
+	cookieTag  string = "cookie"
 )
 
 var Config = struct {
@@ -25,6 +30,9 @@ var Config = struct {
 		Form          ParserFunc
 		Query         ParserFunc
 		MultipartForm ParserFunc
+		Path          ParserFunc
+		Headers       ParserFunc
+		Cookies       ParserFunc
 	}
 }{
 	Parsers: struct {
@@ -32,6 +40,9 @@ var Config = struct {
 		Form          ParserFunc
 		Query         ParserFunc
 		MultipartForm ParserFunc
+		Path          ParserFunc
+		Headers       ParserFunc
+		Cookies       ParserFunc
 	}{
 		JSON: func(r *http.Request) p.DpFactory {
 			return zjson.Decode(r.Body)
@@ -53,7 +64,7 @@ var Config = struct {
 					// See this article on why/how to correctly parse multipart form data: https://medium.com/@owlwalks/dont-parse-everything-from-client-multipart-post-golang-9280d23cd4ad
 					return nil, &p.ZogIssue{Code: zconst.IssueCodeZHTTPInvalidMultipartForm, Err: errors.New("You must parse multipart form data before using it with zhttp")}
 				}
-				return form(r.MultipartForm.Value, &formTag), nil
+				return multipartDataProvider{Form: r.MultipartForm, tag: &formTag}, nil
 			}
 		},
 		Query: func(r *http.Request) p.DpFactory {
@@ -62,6 +73,25 @@ var Config = struct {
 				return form(r.URL.Query(), &queryParam), nil
 			}
 		},
+		Path: func(r *http.Request) p.DpFactory {
+			return func() (p.DataProvider, *p.ZogIssue) {
+				return pathDataProvider{r: r, tag: &pathTag}, nil
+			}
+		},
+		Headers: func(r *http.Request) p.DpFactory {
+			return func() (p.DataProvider, *p.ZogIssue) {
+				return headerDataProvider{Data: r.Header, tag: &headerTag}, nil
+			}
+		},
+		Cookies: func(r *http.Request) p.DpFactory {
+			return func() (p.DataProvider, *p.ZogIssue) {
+				cookies := url.Values{}
+				for _, c := range r.Cookies() {
+					cookies.Add(c.Name, c.Value)
+				}
+				return form(cookies, &cookieTag), nil
+			}
+		},
 	},
 }
 
@@ -71,6 +101,7 @@ type urlDataProvider struct {
 }
 
 var _ p.DataProvider = urlDataProvider{}
+var _ p.KeysDataProvider = urlDataProvider{}
 
 func (u urlDataProvider) Get(key string) any {
 	if !u.Data.Has(key) {
@@ -101,10 +132,18 @@ func (u urlDataProvider) GetUnderlying() any {
 	return u.Data
 }
 
+func (u urlDataProvider) Keys() []string {
+	keys := make([]string, 0, len(u.Data))
+	for k := range u.Data {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
 // Parses JSON, Form & Query data from request based on Content-Type header
 // Usage:
 // schema.Parse(zhttp.Request(r), &dest)
-// WARNING: FOR JSON PARSING DOES NOT SUPPORT JSON ARRAYS OR PRIMITIVES
+// JSON arrays & primitives are parsed by the schemas for those types. i.e z.Slice(z.Struct(...)).Parse(zhttp.Request(r), &items)
 func Request(r *http.Request) p.DpFactory {
 	switch r.Method {
 	case "GET":
@@ -127,6 +166,57 @@ func Request(r *http.Request) p.DpFactory {
 	}
 }
 
+type multipartDataProvider struct {
+	Form *multipart.Form
+	tag  *string
+}
+
+var _ p.DataProvider = multipartDataProvider{}
+var _ p.KeysDataProvider = multipartDataProvider{}
+
+// returns the form value for the key. If there is none the uploaded files, a *multipart.FileHeader for one file & a []*multipart.FileHeader for more
+func (m multipartDataProvider) Get(key string) any {
+	if v := form(m.Form.Value, m.tag).Get(key); v != nil {
+		return v
+	}
+	files := m.Form.File[key]
+	switch len(files) {
+	case 0:
+		return nil
+	case 1:
+		return files[0]
+	default:
+		return files
+	}
+}
+
+func (m multipartDataProvider) GetByField(field reflect.StructField, fallback string) (any, string) {
+	key := p.GetKeyFromField(field, fallback, m.tag)
+	return m.Get(key), key
+}
+
+func (m multipartDataProvider) GetNestedProvider(key string) p.DataProvider {
+	return m
+}
+
+// returns the form values so maps keep parsing as before. Files are only reachable through Get
+func (m multipartDataProvider) GetUnderlying() any {
+	return m.Form.Value
+}
+
+func (m multipartDataProvider) Keys() []string {
+	keys := make([]string, 0, len(m.Form.Value)+len(m.Form.File))
+	for k := range m.Form.Value {
+		keys = append(keys, k)
+	}
+	for k := range m.Form.File {
+		if _, ok := m.Form.Value[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
 func form(data url.Values, tag *string) p.DataProvider {
 	return urlDataProvider{Data: data, tag: tag}
 }
@@ -134,3 +224,249 @@ func form(data url.Values, tag *string) p.DataProvider {
 // func params(data url.Values) p.DataProvider {
 // 	return form(data)
 // }
+
+// Parses the path parameters of the request. Uses the wildcards of the http.ServeMux pattern that matched the request (Go 1.22+). Usage:
+//
+//	mux.HandleFunc("GET /orgs/{orgID}/users/{id}", func(w http.ResponseWriter, r *http.Request) {
+//		errs := schema.Parse(zhttp.Path(r), &dest)
+//	})
+//
+// Fields are matched using the `path` struct tag. Override Config.Parsers.Path to read the params of other routers
+func Path(r *http.Request) p.DpFactory {
+	return Config.Parsers.Path(r)
+}
+
+// implemented by *http.Request since Go 1.22
+type pathValuer interface {
+	PathValue(name string) string
+}
+
+type pathDataProvider struct {
+	r   *http.Request
+	tag *string
+}
+
+var _ p.DataProvider = pathDataProvider{}
+
+func (d pathDataProvider) Get(key string) any {
+	r, ok := any(d.r).(pathValuer)
+	if !ok {
+		return nil
+	}
+	// missing wildcards are empty strings
+	if v := r.PathValue(key); v != "" {
+		return v
+	}
+	return nil
+}
+
+func (d pathDataProvider) GetByField(field reflect.StructField, fallback string) (any, string) {
+	key := p.GetKeyFromField(field, fallback, d.tag)
+	return d.Get(key), key
+}
+
+func (d pathDataProvider) GetNestedProvider(key string) p.DataProvider {
+	return d
+}
+
+func (d pathDataProvider) GetUnderlying() any {
+	return d.r
+}
+
+// Sources combined by All. Also the first element of the issue paths of the fields they fill
+const (
+	SourcePath   = "path"
+	SourceQuery  = "query"
+	SourceHeader = "header"
+	SourceCookie = "cookie"
+	SourceBody   = "body"
+)
+
+// Parses path params, query params, headers, cookies & the body of the request into a single struct. Usage:
+//
+//	type UpdateUser struct {
+//		OrgID   string `path:"orgID"`
+//		ID      int    `path:"id"`
+//		Dry     bool   `query:"dry"`
+//		IfMatch string `header:"If-Match"`
+//		Name    string `json:"name"`
+//	}
+//	errs := schema.Parse(zhttp.All(r), &dest)
+//
+// Fields with a `path`, `query`, `header` or `cookie` tag are read from that source. The rest are read from the body, which is parsed like Request does. For GET & HEAD requests there is no body so they are read from the query params.
+// Issues are pathed by source. i.e path.id, query.dry or body.name
+func All(r *http.Request) p.DpFactory {
+	return func() (p.DataProvider, *p.ZogIssue) {
+		path, err := Config.Parsers.Path(r)()
+		if err != nil {
+			return nil, err
+		}
+		query, err := Config.Parsers.Query(r)()
+		if err != nil {
+			return nil, err
+		}
+		headers, err := Config.Parsers.Headers(r)()
+		if err != nil {
+			return nil, err
+		}
+		cookies, err := Config.Parsers.Cookies(r)()
+		if err != nil {
+			return nil, err
+		}
+		d := combinedDataProvider{path: path, query: query, headers: headers, cookies: cookies}
+		if r.Method != "GET" && r.Method != "HEAD" {
+			d.body, err = Request(r)()
+			if err != nil {
+				return nil, err
+			}
+		}
+		return d, nil
+	}
+}
+
+type combinedDataProvider struct {
+	path    p.DataProvider
+	query   p.DataProvider
+	headers p.DataProvider
+	cookies p.DataProvider
+	// nil if the request has no body
+	body p.DataProvider
+}
+
+var _ p.DataProvider = combinedDataProvider{}
+var _ p.SourceDataProvider = combinedDataProvider{}
+
+// returns the value from the first source that has the key: body, query, path params, headers & cookies. In that order
+func (d combinedDataProvider) Get(key string) any {
+	for _, dp := range []p.DataProvider{d.body, d.query, d.path, d.headers, d.cookies} {
+		if dp == nil {
+			continue
+		}
+		if v := dp.Get(key); v != nil {
+			return v
+		}
+	}
+	return nil
+}
+
+// returns the source the field is read from & its data provider. Fields without a source tag are read from the body or the query if there is no body
+func (d combinedDataProvider) fieldSource(field reflect.StructField) (string, p.DataProvider) {
+	if _, ok := field.Tag.Lookup(pathTag); ok {
+		return SourcePath, d.path
+	}
+	if _, ok := field.Tag.Lookup(headerTag); ok {
+		return SourceHeader, d.headers
+	}
+	if _, ok := field.Tag.Lookup(cookieTag); ok {
+		return SourceCookie, d.cookies
+	}
+	if _, ok := field.Tag.Lookup(queryParam); ok || d.body == nil {
+		return SourceQuery, d.query
+	}
+	return SourceBody, d.body
+}
+
+func (d combinedDataProvider) FieldSource(field reflect.StructField) string {
+	source, _ := d.fieldSource(field)
+	return source
+}
+
+func (d combinedDataProvider) GetByField(field reflect.StructField, fallback string) (any, string) {
+	_, dp := d.fieldSource(field)
+	return dp.GetByField(field, fallback)
+}
+
+func (d combinedDataProvider) GetNestedProvider(key string) p.DataProvider {
+	if d.body != nil {
+		return d.body.GetNestedProvider(key)
+	}
+	return d.query.GetNestedProvider(key)
+}
+
+func (d combinedDataProvider) GetUnderlying() any {
+	underlying := map[string]any{
+		SourcePath:   d.path.GetUnderlying(),
+		SourceQuery:  d.query.GetUnderlying(),
+		SourceHeader: d.headers.GetUnderlying(),
+		SourceCookie: d.cookies.GetUnderlying(),
+	}
+	if d.body != nil {
+		underlying[SourceBody] = d.body.GetUnderlying()
+	}
+	return underlying
+}
+
+// Parses the headers of the request. Usage:
+//
+//	type Auth struct {
+//		Token    string   `header:"Authorization"`
+//		Language []string `header:"Accept-Language"`
+//	}
+//	errs := schema.Parse(zhttp.Headers(r), &dest)
+//
+// Fields are matched using the `header` struct tag. The lookup is case insensitive & headers sent more than once are slices
+func Headers(r *http.Request) p.DpFactory {
+	return Config.Parsers.Headers(r)
+}
+
+// Parses the cookies of the request. Fields are matched using the `cookie` struct tag. Cookies sent more than once are slices. Usage:
+//
+//	type Session struct {
+//		ID string `cookie:"session_id"`
+//	}
+//	errs := schema.Parse(zhttp.Cookies(r), &dest)
+func Cookies(r *http.Request) p.DpFactory {
+	return Config.Parsers.Cookies(r)
+}
+
+type headerDataProvider struct {
+	Data http.Header
+	tag  *string
+}
+
+var _ p.DataProvider = headerDataProvider{}
+
+func (h headerDataProvider) Get(key string) any {
+	// Values canonicalizes the key so the lookup is case insensitive
+	values := h.Data.Values(key)
+	switch len(values) {
+	case 0:
+		return nil
+	case 1:
+		return values[0]
+	default:
+		return values
+	}
+}
+
+// Slice fields also accept comma separated lists (i.e X-Ids: 1,2,3) since HTTP treats them the same as repeating the header
+func (h headerDataProvider) GetByField(field reflect.StructField, fallback string) (any, string) {
+	key := p.GetKeyFromField(field, fallback, h.tag)
+	typ := field.Type
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Slice {
+		return h.Get(key), key
+	}
+	var values []string
+	for _, v := range h.Data.Values(key) {
+		for _, item := range strings.Split(v, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				values = append(values, item)
+			}
+		}
+	}
+	if len(values) == 0 {
+		return nil, key
+	}
+	return values, key
+}
+
+func (h headerDataProvider) GetNestedProvider(key string) p.DataProvider {
+	return h
+}
+
+func (h headerDataProvider) GetUnderlying() any {
+	return h.Data
+}
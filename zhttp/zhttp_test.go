@@ -1,12 +1,17 @@
+//go:debug httpmuxgo121=0
+
 package zhttp
 
 import (
 	"bytes"
 	"encoding/json"
+	"mime/multipart"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	z "github.com/Oudwins/zog"
 	"github.com/Oudwins/zog/zconst"
@@ -264,7 +269,7 @@ func TestRequestContentTypeJSON(t *testing.T) {
 	dp, err := dpFactory()
 	assert.Nil(t, err)
 	assert.Equal(t, "John", dp.Get("name"))
-	assert.Equal(t, float64(30), dp.Get("age"))
+	assert.Equal(t, json.Number("30"), dp.Get("age"))
 }
 
 func TestRequestContentTypeForm(t *testing.T) {
@@ -299,7 +304,7 @@ func TestParseJsonValid(t *testing.T) {
 	dp, err := Config.Parsers.JSON(req)()
 	assert.Nil(t, err)
 	assert.Equal(t, "John", dp.Get("name"))
-	assert.Equal(t, float64(30), dp.Get("age"))
+	assert.Equal(t, json.Number("30"), dp.Get("age"))
 }
 
 func TestParseJsonWithComplexContentType(t *testing.T) {
@@ -310,7 +315,7 @@ func TestParseJsonWithComplexContentType(t *testing.T) {
 	dp, err := Config.Parsers.JSON(req)()
 	assert.Nil(t, err)
 	assert.Equal(t, "John", dp.Get("name"))
-	assert.Equal(t, float64(30), dp.Get("age"))
+	assert.Equal(t, json.Number("30"), dp.Get("age"))
 }
 
 func TestParseJsonInvalid(t *testing.T) {
@@ -344,6 +349,127 @@ func TestParseJsonWithEmptyObject(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func jsonRequest(body string) *http.Request {
+	req, _ := http.NewRequest("POST", "/test", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func TestParseJsonArray(t *testing.T) {
+	type Item struct {
+		Name string `json:"name"`
+		Qty  int    `json:"qty"`
+	}
+	itemsSchema := z.Slice(z.Struct(z.Shape{
+		"name": z.String().Required(),
+		"qty":  z.Int().GT(0),
+	})).Min(1)
+
+	var items []Item
+	errs := itemsSchema.Parse(Request(jsonRequest(`[{"name":"apple","qty":2},{"name":"pear","qty":1}]`)), &items)
+	assert.Empty(t, errs)
+	assert.Equal(t, []Item{{Name: "apple", Qty: 2}, {Name: "pear", Qty: 1}}, items)
+
+	errs = itemsSchema.Parse(Request(jsonRequest(`[{"name":"apple","qty":0}]`)), &items)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "[0].qty", errs[0].PathString())
+
+	errs = itemsSchema.Parse(Request(jsonRequest(`[]`)), &items)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeMin, errs[0].Code)
+
+	// optional top level slices
+	var ptr *[]Item
+	errs = z.Ptr(itemsSchema).Parse(Request(jsonRequest(`[{"name":"apple"}]`)), &ptr)
+	assert.Empty(t, errs)
+	assert.Len(t, *ptr, 1)
+
+	var ids []int
+	errs = z.Slice(z.Int()).Parse(Request(jsonRequest(`[1, 2, 3]`)), &ids)
+	assert.Empty(t, errs)
+	assert.Equal(t, []int{1, 2, 3}, ids)
+}
+
+func TestParseJsonPrimitives(t *testing.T) {
+	var n int
+	errs := z.Int().GT(10).Parse(Request(jsonRequest(`42`)), &n)
+	assert.Empty(t, errs)
+	assert.Equal(t, 42, n)
+
+	var s string
+	errs = z.String().Email().Parse(Request(jsonRequest(`"john@doe.com"`)), &s)
+	assert.Empty(t, errs)
+	assert.Equal(t, "john@doe.com", s)
+
+	var b bool
+	errs = z.Bool().Parse(Request(jsonRequest(`true`)), &b)
+	assert.Empty(t, errs)
+	assert.True(t, b)
+
+	// the request error is still reported
+	errs = z.Int().Parse(Request(jsonRequest(`"42`)), &n)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeInvalidJSON, errs[0].Code)
+}
+
+func TestParseJsonNumberPrecision(t *testing.T) {
+	type Order struct {
+		OrderID int64   `json:"id"`
+		Serial  uint64  `json:"serial"`
+		Qty     int     `json:"qty"`
+		Price   float64 `json:"price"`
+		Ref     string  `json:"ref"`
+	}
+	orderSchema := z.Struct(z.Shape{
+		"orderID": z.Int64(),
+		"serial":  z.UintLike[uint64](),
+		"qty":     z.Int(),
+		"price":   z.Float64(),
+		"ref":     z.String(),
+	})
+
+	var order Order
+	errs := orderSchema.Parse(Request(jsonRequest(`{"id":9007199254740993,"serial":18446744073709551615,"qty":2.0,"price":1.5,"ref":12345678901234567890}`)), &order)
+	assert.Empty(t, errs)
+	assert.Equal(t, Order{OrderID: 9007199254740993, Serial: 18446744073709551615, Qty: 2, Price: 1.5, Ref: "12345678901234567890"}, order)
+
+	// fractions are not truncated
+	errs = orderSchema.Parse(Request(jsonRequest(`{"qty":1.5}`)), &order)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "qty", errs[0].PathString())
+	assert.Equal(t, zconst.IssueCodeCoerce, errs[0].Code)
+
+	var ids []int64
+	errs = z.Slice(z.Int64()).Parse(Request(jsonRequest(`[9007199254740993, 9007199254740995]`)), &ids)
+	assert.Empty(t, errs)
+	assert.Equal(t, []int64{9007199254740993, 9007199254740995}, ids)
+}
+
+func TestParseJsonShapeMismatch(t *testing.T) {
+	type User struct {
+		Name string `json:"name"`
+	}
+	var user User
+	errs := z.Struct(z.Shape{"name": z.String().Required()}).Parse(Request(jsonRequest(`[{"name":"John"}]`)), &user)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeCoerce, errs[0].Code)
+
+	var n int
+	errs = z.Int().Parse(Request(jsonRequest(`[1]`)), &n)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeCoerce, errs[0].Code)
+
+	var m map[string]int
+	errs = z.Map(z.String(), z.Int()).Parse(Request(jsonRequest(`[1]`)), &m)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeCoerce, errs[0].Code)
+
+	// empty objects are parsed as empty maps
+	errs = z.Map(z.String(), z.Int()).Required().Parse(Request(jsonRequest(`{}`)), &m)
+	assert.Empty(t, errs)
+	assert.Empty(t, m)
+}
+
 func TestParseDeeplyNestedJson(t *testing.T) {
 	schema := z.Struct(z.Shape{
 		"name": z.String().Required(),
@@ -393,6 +519,10 @@ func TestTopLevelOptionalStruct(t *testing.T) {
 	user := &User{}
 	errs := schema.Parse(Request(req), &user)
 	assert.Nil(t, errs)
+
+	errs = schema.Parse(Request(jsonRequest(`{"name":"John"}`)), &user)
+	assert.Nil(t, errs)
+	assert.Equal(t, "John", user.Name)
 }
 
 func TestForm(t *testing.T) {
@@ -486,3 +616,273 @@ func TestOptionalParse(t *testing.T) {
 	assert.Equal(t, int64(5), pagination.Page)
 	assert.Equal(t, int64(10), pagination.PageSize)
 }
+
+func TestRequestStrictQuery(t *testing.T) {
+	req, err := http.NewRequest("GET", "/submit?name=zog&nmae=typo", nil)
+	assert.Nil(t, err)
+	type User struct {
+		Name string `query:"name"`
+	}
+	schema := z.Struct(z.Shape{"name": z.String()}).Strict()
+
+	var u User
+	errs := schema.Parse(Request(req), &u)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeUnrecognizedKeys, errs[0].Code)
+	assert.Equal(t, []string{"nmae"}, errs[0].Params[zconst.IssueCodeUnrecognizedKeys])
+	assert.Equal(t, "zog", u.Name)
+}
+
+// serves req with a mux that has the pattern & returns the request the handler got so path values are set
+func routed(t *testing.T, pattern string, req *http.Request) *http.Request {
+	var routedReq *http.Request
+	mux := http.NewServeMux()
+	mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		routedReq = r
+	})
+	mux.ServeHTTP(httptest.NewRecorder(), req)
+	if routedReq == nil {
+		t.Fatalf("pattern %s did not match %s", pattern, req.URL)
+	}
+	return routedReq
+}
+
+func TestPath(t *testing.T) {
+	req := routed(t, "GET /orgs/{orgID}/users/{id}", httptest.NewRequest("GET", "/orgs/acme/users/42", nil))
+	type Params struct {
+		OrgID  string `path:"orgID"`
+		UserID int    `path:"id"`
+	}
+	schema := z.Struct(z.Shape{
+		"orgID":  z.String().Required(),
+		"userID": z.Int().GT(0),
+	})
+	var dest Params
+	errs := schema.Parse(Path(req), &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, Params{OrgID: "acme", UserID: 42}, dest)
+
+	req = routed(t, "GET /orgs/{orgID}/users/{id}", httptest.NewRequest("GET", "/orgs/acme/users/abc", nil))
+	dest = Params{}
+	errs = schema.Parse(Path(req), &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeCoerce, errs[0].Code)
+	assert.Equal(t, []string{"id"}, errs[0].Path)
+}
+
+func TestAll(t *testing.T) {
+	body, _ := json.Marshal(map[string]any{"name": "zog"})
+	req := routed(t, "POST /orgs/{orgID}/users/{id}", httptest.NewRequest("POST", "/orgs/acme/users/0?dry=true", bytes.NewReader(body)))
+	req.Header.Set("Content-Type", "application/json")
+	type UpdateUser struct {
+		OrgID  string `path:"orgID"`
+		UserID int    `path:"id"`
+		Dry    bool   `query:"dry"`
+		Name   string `json:"name"`
+		Email  string `json:"email"`
+	}
+	schema := z.Struct(z.Shape{
+		"orgID":  z.String().Required(),
+		"userID": z.Int().GT(0),
+		"dry":    z.Bool(),
+		"name":   z.String().Required(),
+		"email":  z.String().Required(),
+	})
+	var dest UpdateUser
+	errs := schema.Parse(All(req), &dest)
+	assert.Len(t, errs, 2)
+	assert.Equal(t, []string{"path", "id"}, errs[0].Path)
+	assert.Equal(t, zconst.IssueCodeGT, errs[0].Code)
+	assert.Equal(t, []string{"body", "email"}, errs[1].Path)
+	assert.Equal(t, zconst.IssueCodeRequired, errs[1].Code)
+	assert.Equal(t, UpdateUser{OrgID: "acme", Dry: true, Name: "zog"}, dest)
+
+	// GET requests read untagged fields from the query
+	req = routed(t, "GET /orgs/{orgID}/users", httptest.NewRequest("GET", "/orgs/acme/users?name=zog", nil))
+	type ListUsers struct {
+		OrgID string `path:"orgID"`
+		Name  string
+	}
+	var list ListUsers
+	errs = z.Struct(z.Shape{"orgID": z.String(), "name": z.String().Required()}).Parse(All(req), &list)
+	assert.Empty(t, errs)
+	assert.Equal(t, ListUsers{OrgID: "acme", Name: "zog"}, list)
+
+	// body errors are returned as is
+	req = routed(t, "POST /orgs/{orgID}/users/{id}", httptest.NewRequest("POST", "/orgs/acme/users/1", strings.NewReader("{")))
+	req.Header.Set("Content-Type", "application/json")
+	errs = schema.Parse(All(req), &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeInvalidJSON, errs[0].Code)
+}
+
+func TestHeaders(t *testing.T) {
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	req.Header.Add("Accept-Language", "en")
+	req.Header.Add("Accept-Language", "es")
+	req.Header.Set("X-Tenant-Id", "7")
+	req.Header.Set("If-Unmodified-Since", "Mon, 02 Jan 2006 15:04:05 GMT")
+	type Meta struct {
+		Token     string    `header:"authorization"`
+		Languages []string  `header:"Accept-Language"`
+		Tenant    int       `header:"x-tenant-id"`
+		Since     time.Time `header:"If-Unmodified-Since"`
+		Missing   []string  `header:"X-Missing"`
+	}
+	schema := z.Struct(z.Shape{
+		"token":     z.String().Required().HasPrefix("Bearer "),
+		"languages": z.Slice(z.String()).Min(1),
+		"tenant":    z.Int().GT(0),
+		"since":     z.Time(z.Time.Format(http.TimeFormat)),
+		"missing":   z.Slice(z.String()),
+	})
+	var dest Meta
+	errs := schema.Parse(Headers(req), &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, "Bearer token", dest.Token)
+	assert.Equal(t, []string{"en", "es"}, dest.Languages)
+	assert.Equal(t, 7, dest.Tenant)
+	assert.Equal(t, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), dest.Since.UTC())
+	assert.Nil(t, dest.Missing)
+
+	// single values are boxed by slice schemas
+	req.Header.Del("Accept-Language")
+	req.Header.Set("Accept-Language", "fr")
+	req.Header.Set("X-Tenant-Id", "abc")
+	dest = Meta{}
+	errs = schema.Parse(Headers(req), &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, []string{"x-tenant-id"}, errs[0].Path)
+	assert.Equal(t, []string{"fr"}, dest.Languages)
+}
+
+func TestHeadersCommaSeparatedSlices(t *testing.T) {
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set("X-Ids", "1, 2,3")
+	req.Header.Add("X-Ids", "4")
+	req.Header.Set("X-Name", "a,b")
+	type Filter struct {
+		IDs  []int  `header:"X-Ids"`
+		Name string `header:"X-Name"`
+	}
+	schema := z.Struct(z.Shape{
+		"iDs":  z.Slice(z.Int()),
+		"name": z.String(),
+	})
+	var dest Filter
+	errs := schema.Parse(Headers(req), &dest)
+	assert.Empty(t, errs)
+	// only slices are split, other fields get the header as it was sent
+	assert.Equal(t, Filter{IDs: []int{1, 2, 3, 4}, Name: "a,b"}, dest)
+
+	// same when the headers are read through All
+	dest = Filter{}
+	errs = schema.Parse(All(req), &dest)
+	assert.Empty(t, errs)
+	assert.Equal(t, []int{1, 2, 3, 4}, dest.IDs)
+}
+
+func TestCookies(t *testing.T) {
+	req := httptest.NewRequest("GET", "/", nil)
+	req.AddCookie(&http.Cookie{Name: "session_id", Value: "abc"})
+	req.AddCookie(&http.Cookie{Name: "flag", Value: "a"})
+	req.AddCookie(&http.Cookie{Name: "flag", Value: "b"})
+	type Session struct {
+		ID    string   `cookie:"session_id"`
+		Flags []string `cookie:"flag"`
+		Theme string   `cookie:"theme"`
+	}
+	schema := z.Struct(z.Shape{
+		"iD":    z.String().Required(),
+		"flags": z.Slice(z.String()),
+		"theme": z.String().Required(),
+	})
+	var dest Session
+	errs := schema.Parse(Cookies(req), &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, []string{"theme"}, errs[0].Path)
+	assert.Equal(t, zconst.IssueCodeRequired, errs[0].Code)
+	assert.Equal(t, Session{ID: "abc", Flags: []string{"a", "b"}}, dest)
+}
+
+func TestAllHeadersAndCookies(t *testing.T) {
+	req := routed(t, "GET /items/{id}", httptest.NewRequest("GET", "/items/1", nil))
+	req.Header.Set("If-Match", `"v1"`)
+	req.AddCookie(&http.Cookie{Name: "tenant", Value: "0"})
+	type GetItem struct {
+		ItemID  int    `path:"id"`
+		IfMatch string `header:"If-Match"`
+		Tenant  int    `cookie:"tenant"`
+	}
+	schema := z.Struct(z.Shape{
+		"itemID":  z.Int(),
+		"ifMatch": z.String().Required(),
+		"tenant":  z.Int().GT(0),
+	})
+	var dest GetItem
+	errs := schema.Parse(All(req), &dest)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, []string{"cookie", "tenant"}, errs[0].Path)
+	assert.Equal(t, GetItem{ItemID: 1, IfMatch: `"v1"`}, dest)
+}
+
+func TestAllGetFallsBackToHeadersAndCookies(t *testing.T) {
+	req := httptest.NewRequest("GET", "/?dry=true", nil)
+	req.Header.Set("X-Tenant", "acme")
+	req.AddCookie(&http.Cookie{Name: "session", Value: "abc"})
+	dp, err := All(req)()
+	assert.Nil(t, err)
+	assert.Equal(t, "true", dp.Get("dry"))
+	assert.Equal(t, "acme", dp.Get("X-Tenant"))
+	assert.Equal(t, "abc", dp.Get("session"))
+	assert.Nil(t, dp.Get("missing"))
+}
+
+func TestMultipartForm(t *testing.T) {
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+	assert.NoError(t, w.WriteField("title", "Holidays"))
+	for _, name := range []string{"avatar.png", "beach.png", "sunset.png"} {
+		field := "photos"
+		if name == "avatar.png" {
+			field = "avatar"
+		}
+		part, err := w.CreateFormFile(field, name)
+		assert.NoError(t, err)
+		_, err = part.Write([]byte("\x89PNG\r\n\x1a\n"))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, w.Close())
+
+	req := httptest.NewRequest("POST", "/albums", body)
+	req.Header.Set("Content-Type", w.FormDataContentType())
+
+	type Album struct {
+		Title  string                  `form:"title"`
+		Avatar *multipart.FileHeader   `form:"avatar"`
+		Photos []*multipart.FileHeader `form:"photos"`
+	}
+	albumSchema := z.Struct(z.Shape{
+		"title":  z.String().Required(),
+		"avatar": z.File().Required().MimeType([]string{"image/png"}),
+		"photos": z.Slice(z.File().Extension([]string{"png"})).Min(1),
+	})
+
+	// the form must be parsed by the user
+	var album Album
+	errs := albumSchema.Parse(Request(req), &album)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, zconst.IssueCodeZHTTPInvalidMultipartForm, errs[0].Code)
+
+	assert.NoError(t, req.ParseMultipartForm(1<<20))
+	errs = albumSchema.Parse(Request(req), &album)
+	assert.Empty(t, errs)
+	assert.Equal(t, "Holidays", album.Title)
+	assert.Equal(t, "avatar.png", album.Avatar.Filename)
+	assert.Len(t, album.Photos, 2)
+	assert.Equal(t, "beach.png", album.Photos[0].Filename)
+
+	dp, _ := Config.Parsers.MultipartForm(req)()
+	assert.ElementsMatch(t, []string{"title", "avatar", "photos"}, dp.(multipartDataProvider).Keys())
+}
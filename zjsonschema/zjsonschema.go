@@ -0,0 +1,432 @@
+package zjsonschema
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	z "github.com/Oudwins/zog"
+	p "github.com/Oudwins/zog/internals"
+	"github.com/Oudwins/zog/zconst"
+)
+
+// JSON Schema dialect of the generated documents
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// A JSON Schema (draft 2020-12) document. Only the keywords zog can produce are supported. Marshal it with encoding/json
+type Schema struct {
+	Schema string             `json:"$schema,omitempty"`
+	Ref    string             `json:"$ref,omitempty"`
+	Defs   map[string]*Schema `json:"$defs,omitempty"`
+
+	Type    string `json:"type,omitempty"`
+	Const   any    `json:"const,omitempty"`
+	Enum    []any  `json:"enum,omitempty"`
+	Default any    `json:"default,omitempty"`
+
+	// strings
+	MinLength *int   `json:"minLength,omitempty"`
+	MaxLength *int   `json:"maxLength,omitempty"`
+	Pattern   string `json:"pattern,omitempty"`
+	Format    string `json:"format,omitempty"`
+
+	// numbers
+	Minimum          any `json:"minimum,omitempty"`
+	Maximum          any `json:"maximum,omitempty"`
+	ExclusiveMinimum any `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum any `json:"exclusiveMaximum,omitempty"`
+
+	// arrays
+	PrefixItems []*Schema `json:"prefixItems,omitempty"`
+	Items       *Schema   `json:"items,omitempty"`
+	MinItems    *int      `json:"minItems,omitempty"`
+	MaxItems    *int      `json:"maxItems,omitempty"`
+	Contains    *Schema   `json:"contains,omitempty"`
+
+	// objects
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	PropertyNames        *Schema            `json:"propertyNames,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	MinProperties        *int               `json:"minProperties,omitempty"`
+	MaxProperties        *int               `json:"maxProperties,omitempty"`
+
+	// composition
+	AllOf []*Schema `json:"allOf,omitempty"`
+	AnyOf []*Schema `json:"anyOf,omitempty"`
+	OneOf []*Schema `json:"oneOf,omitempty"`
+}
+
+// Returns the JSON Schema document for a zog schema. Tests are mapped to the closest JSON Schema keyword:
+//   - Min/Max/Len -> minLength/maxLength, minItems/maxItems or minProperties/maxProperties
+//   - GT/GTE/LT/LTE -> exclusiveMinimum/minimum/exclusiveMaximum/maximum
+//   - OneOf -> enum, EQ -> const
+//   - Email/URL/UUID/IPv4/IPv6 -> format, IP -> anyOf ipv4/ipv6 formats
+//   - Match/HasPrefix/HasSuffix/Contains -> pattern
+//   - Required -> required in the parent object, Default -> default
+//
+// Tests without a JSON Schema equivalent (i.e custom tests, negated tests or time tests) are skipped. Recursive schemas are exported using $defs & $ref. Usage:
+//
+//	doc := zjsonschema.From(userSchema)
+//	b, err := json.MarshalIndent(doc, "", "  ")
+func From(schema z.ZogSchema, opts ...Option) *Schema {
+	c := &converter{
+		root:       z.Describe(schema),
+		inProgress: map[*z.SchemaDescription]bool{},
+		refs:       map[*z.SchemaDescription]string{},
+		defs:       map[string]*Schema{},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	s := c.convert(c.root, c.destType)
+	s.Schema = Draft
+	if len(c.defs) > 0 {
+		s.Defs = c.defs
+	}
+	return s
+}
+
+// Options for From
+type Option = func(c *converter)
+
+// Names the object properties after the struct tags of the destination (i.e the `json` tag) instead of the shape keys.
+// Same as parsing, it falls back to the `zog` tag and then to the shape key. dest is a value or pointer of the type you parse into
+func WithStructTags(dest any, tag string) Option {
+	return func(c *converter) {
+		c.destType = reflect.TypeOf(dest)
+		c.tag = tag
+	}
+}
+
+type converter struct {
+	destType reflect.Type
+	tag      string
+
+	root       *z.SchemaDescription
+	inProgress map[*z.SchemaDescription]bool
+	// name of the $defs entry for descriptions that are referenced recursively
+	refs map[*z.SchemaDescription]string
+	defs map[string]*Schema
+}
+
+// converts the description. typ is the destination type used to name properties after struct tags or nil
+func (c *converter) convert(desc *z.SchemaDescription, typ reflect.Type) *Schema {
+	// recursive reference to a schema we are still converting
+	if c.inProgress[desc] {
+		return &Schema{Ref: c.ref(desc)}
+	}
+	c.inProgress[desc] = true
+	s := c.convertDesc(desc, derefType(typ))
+	delete(c.inProgress, desc)
+
+	if name, ok := c.refs[desc]; ok && desc != c.root {
+		c.defs[name] = s
+		return &Schema{Ref: "#/$defs/" + name}
+	}
+	return s
+}
+
+func (c *converter) ref(desc *z.SchemaDescription) string {
+	if desc == c.root {
+		return "#"
+	}
+	name, ok := c.refs[desc]
+	if !ok {
+		name = fmt.Sprintf("schema%d", len(c.refs)+1)
+		c.refs[desc] = name
+	}
+	return "#/$defs/" + name
+}
+
+func (c *converter) convertDesc(desc *z.SchemaDescription, typ reflect.Type) *Schema {
+	s := &Schema{Default: desc.Default}
+
+	switch {
+	case desc.Discriminator != "":
+		keys := sortedKeys(desc.Variants)
+		for _, key := range keys {
+			variant := c.convert(desc.Variants[key], typ)
+			s.OneOf = append(s.OneOf, &Schema{
+				AllOf: []*Schema{variant, {
+					Type:       "object",
+					Properties: map[string]*Schema{desc.Discriminator: {Const: key}},
+					Required:   []string{desc.Discriminator},
+				}},
+			})
+		}
+		return s
+	case desc.Type == zconst.TypePtr:
+		// nil pointers are omitted fields so the pointer is exported as the schema it points to
+		return c.convert(desc.Schema, typ)
+	}
+
+	switch desc.Type {
+	case zconst.TypeString:
+		s.Type = "string"
+	case zconst.TypeNumber:
+		s.Type = "number"
+		if desc.GoType != nil && isInteger(desc.GoType.Kind()) {
+			s.Type = "integer"
+		}
+	case zconst.TypeBool:
+		s.Type = "boolean"
+	case zconst.TypeEnum:
+		s.Type = kindType(desc.GoType)
+	case zconst.TypeTime:
+		s.Type = "string"
+		s.Format = "date-time"
+		// time values are not valid json so the default is dropped
+		s.Default = nil
+	case zconst.TypeFile:
+		// the OpenAPI representation of uploaded files
+		s.Type = "string"
+		s.Format = "binary"
+	case zconst.TypeSlice:
+		s.Type = "array"
+		s.Items = c.convert(desc.Schema, elemType(typ))
+	case zconst.TypeTuple:
+		s.Type = "array"
+		for i, item := range desc.Items {
+			s.PrefixItems = append(s.PrefixItems, c.convert(item, tupleItemType(typ, i, false)))
+		}
+		n := len(desc.Items)
+		s.MinItems = &n
+		if desc.Schema != nil {
+			s.Items = c.convert(desc.Schema, tupleItemType(typ, n, true))
+		} else {
+			s.MaxItems = &n
+		}
+	case zconst.TypeMap:
+		s.Type = "object"
+		if desc.Key.Type == zconst.TypeString {
+			s.PropertyNames = c.convert(desc.Key, nil)
+		}
+		s.AdditionalProperties = c.convert(desc.Schema, elemType(typ))
+	case zconst.TypeStruct:
+		s.Type = "object"
+		s.Properties = make(map[string]*Schema, len(desc.Shape))
+		for _, key := range sortedKeys(desc.Shape) {
+			field := desc.Shape[key]
+			name, fieldType := c.field(typ, key)
+			s.Properties[name] = c.convert(field, fieldType)
+			if field.Required {
+				s.Required = append(s.Required, name)
+			}
+		}
+	case zconst.TypeUnion:
+		for _, option := range desc.Options {
+			s.AnyOf = append(s.AnyOf, c.convert(option, typ))
+		}
+	case zconst.TypeWhen:
+		// the condition depends on a sibling field so the value may match either schema. If a branch skips the field any value is valid
+		if desc.Then != nil && desc.Otherwise != nil {
+			s.AnyOf = []*Schema{c.convert(desc.Then, typ), c.convert(desc.Otherwise, typ)}
+		}
+	}
+
+	for _, t := range desc.Tests {
+		applyTest(s, desc.Type, t)
+	}
+	// enum names are valid input as well. They are strings so they get their own schema instead of mixing types in enum
+	if len(desc.EnumNames) > 0 {
+		names := &Schema{Type: "string"}
+		for _, name := range sortedKeys(desc.EnumNames) {
+			names.Enum = append(names.Enum, name)
+		}
+		s = &Schema{Default: s.Default, AnyOf: []*Schema{s, names}}
+		s.AnyOf[0].Default = nil
+	}
+	return s
+}
+
+// returns the json type for values of the go type or "" if there is none
+func kindType(typ reflect.Type) string {
+	if typ == nil {
+		return ""
+	}
+	switch k := typ.Kind(); {
+	case k == reflect.String:
+		return "string"
+	case k == reflect.Bool:
+		return "boolean"
+	case isInteger(k):
+		return "integer"
+	case k == reflect.Float32 || k == reflect.Float64:
+		return "number"
+	}
+	return ""
+}
+
+// returns the property name & type of the struct field for the shape key. Follows the same rules as parsing to find the field
+func (c *converter) field(typ reflect.Type, key string) (string, reflect.Type) {
+	if typ == nil || typ.Kind() != reflect.Struct || c.tag == "" {
+		return key, nil
+	}
+	field, ok := typ.FieldByName(strings.ToUpper(key[:1]) + key[1:])
+	if !ok {
+		return key, nil
+	}
+	return p.GetKeyFromField(field, key, &c.tag), field.Type
+}
+
+func derefType(typ reflect.Type) reflect.Type {
+	for typ != nil && typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	return typ
+}
+
+// returns the element type of slices & maps or nil
+func elemType(typ reflect.Type) reflect.Type {
+	if typ == nil {
+		return nil
+	}
+	switch typ.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return typ.Elem()
+	}
+	return nil
+}
+
+// returns the type of the tuple item at idx or nil. For struct destinations the field at idx holds the item, or the rest items if idx is past the positional ones
+func tupleItemType(typ reflect.Type, idx int, rest bool) reflect.Type {
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return elemType(typ)
+	}
+	n := 0
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if n == idx && rest {
+			return elemType(field.Type)
+		}
+		if n == idx {
+			return field.Type
+		}
+		n++
+	}
+	return nil
+}
+
+// sets the keyword for the test. Tests without an equivalent are ignored
+func applyTest(s *Schema, typ zconst.ZogType, t z.TestDescription) {
+	param := t.Params[t.IssueCode]
+	switch t.IssueCode {
+	case zconst.IssueCodeMin, zconst.IssueCodeMax, zconst.IssueCodeLen:
+		n, ok := param.(int)
+		if !ok {
+			return
+		}
+		minPtr, maxPtr := lengthKeywords(s, typ)
+		if minPtr == nil {
+			return
+		}
+		if t.IssueCode != zconst.IssueCodeMax {
+			*minPtr = &n
+		}
+		if t.IssueCode != zconst.IssueCodeMin {
+			*maxPtr = &n
+		}
+	case zconst.IssueCodeGT:
+		s.ExclusiveMinimum = param
+	case zconst.IssueCodeGTE:
+		s.Minimum = param
+	case zconst.IssueCodeLT:
+		s.ExclusiveMaximum = param
+	case zconst.IssueCodeLTE:
+		s.Maximum = param
+	case zconst.IssueCodeEQ, zconst.IssueCodeLiteral:
+		s.Const = param
+	case zconst.IssueCodeTrue:
+		s.Const = true
+	case zconst.IssueCodeFalse:
+		s.Const = false
+	case zconst.IssueCodeOneOf:
+		rv := reflect.ValueOf(param)
+		if rv.Kind() != reflect.Slice {
+			return
+		}
+		s.Enum = make([]any, rv.Len())
+		for i := range s.Enum {
+			s.Enum[i] = rv.Index(i).Interface()
+		}
+	case zconst.IssueCodeEmail:
+		s.Format = "email"
+	case zconst.IssueCodeURL:
+		s.Format = "uri"
+	case zconst.IssueCodeUUID:
+		s.Format = "uuid"
+	case zconst.IssueCodeIP:
+		switch param {
+		case zconst.IPv4:
+			s.Format = "ipv4"
+		case zconst.IPv6:
+			s.Format = "ipv6"
+		default:
+			s.AnyOf = append(s.AnyOf, &Schema{Format: "ipv4"}, &Schema{Format: "ipv6"})
+		}
+	case zconst.IssueCodeMatch:
+		if pattern, ok := param.(string); ok {
+			addPattern(s, pattern)
+		}
+	case zconst.IssueCodeHasPrefix:
+		if prefix, ok := param.(string); ok {
+			addPattern(s, "^"+regexp.QuoteMeta(prefix))
+		}
+	case zconst.IssueCodeHasSuffix:
+		if suffix, ok := param.(string); ok {
+			addPattern(s, regexp.QuoteMeta(suffix)+"$")
+		}
+	case zconst.IssueCodeContains:
+		if typ == zconst.TypeSlice {
+			s.Contains = &Schema{Const: param}
+		} else if sub, ok := param.(string); ok {
+			addPattern(s, regexp.QuoteMeta(sub))
+		}
+	}
+}
+
+// returns the min & max keywords used by the length tests of the type
+func lengthKeywords(s *Schema, typ zconst.ZogType) (**int, **int) {
+	switch typ {
+	case zconst.TypeString:
+		return &s.MinLength, &s.MaxLength
+	case zconst.TypeSlice:
+		return &s.MinItems, &s.MaxItems
+	case zconst.TypeMap:
+		return &s.MinProperties, &s.MaxProperties
+	}
+	return nil, nil
+}
+
+// a schema can only have one pattern so any extra patterns are added with allOf
+func addPattern(s *Schema, pattern string) {
+	if s.Pattern == "" {
+		s.Pattern = pattern
+		return
+	}
+	s.AllOf = append(s.AllOf, &Schema{Pattern: pattern})
+}
+
+func isInteger(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
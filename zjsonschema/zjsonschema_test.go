@@ -0,0 +1,227 @@
+package zjsonschema
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	z "github.com/Oudwins/zog"
+	"github.com/stretchr/testify/assert"
+)
+
+func toJSON(t *testing.T, s *Schema) string {
+	b, err := json.Marshal(s)
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestStringSchema(t *testing.T) {
+	s := From(z.String().Min(3).Max(10).Email().Default("a@b.co"))
+	assert.JSONEq(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "string",
+		"minLength": 3,
+		"maxLength": 10,
+		"format": "email",
+		"default": "a@b.co"
+	}`, toJSON(t, s))
+}
+
+func TestStringPatternsAndEnums(t *testing.T) {
+	s := From(z.String().Match(regexp.MustCompile(`^[a-z]+$`)).HasPrefix("a.b"))
+	assert.Equal(t, `^[a-z]+$`, s.Pattern)
+	assert.Equal(t, `^a\.b`, s.AllOf[0].Pattern)
+
+	s = From(z.String().OneOf([]string{"a", "b"}).Len(1))
+	assert.Equal(t, []any{"a", "b"}, s.Enum)
+	assert.Equal(t, 1, *s.MinLength)
+	assert.Equal(t, 1, *s.MaxLength)
+
+	assert.Equal(t, "uri", From(z.String().URL()).Format)
+	assert.Equal(t, "uuid", From(z.String().UUID()).Format)
+	assert.Equal(t, "ipv4", From(z.String().IPv4()).Format)
+	assert.Len(t, From(z.String().IP()).AnyOf, 2)
+}
+
+func TestNumberSchema(t *testing.T) {
+	assert.JSONEq(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "integer",
+		"exclusiveMinimum": 0,
+		"maximum": 100
+	}`, toJSON(t, From(z.Int().GT(0).LTE(100))))
+
+	s := From(z.Float64().GTE(1.5).LT(2))
+	assert.Equal(t, "number", s.Type)
+	assert.Equal(t, 1.5, s.Minimum)
+	assert.Equal(t, float64(2), s.ExclusiveMaximum)
+}
+
+func TestBoolAndTime(t *testing.T) {
+	assert.JSONEq(t, `{"$schema": "https://json-schema.org/draft/2020-12/schema", "type": "boolean", "const": true}`, toJSON(t, From(z.Bool().True())))
+	assert.JSONEq(t, `{"$schema": "https://json-schema.org/draft/2020-12/schema", "type": "string", "format": "date-time"}`, toJSON(t, From(z.Time())))
+}
+
+func TestStructSchema(t *testing.T) {
+	schema := z.Struct(z.Shape{
+		"name":  z.String().Required(),
+		"age":   z.Int().GTE(18),
+		"tags":  z.Slice(z.String()).Min(1).Max(5).Contains("go"),
+		"meta":  z.Map(z.String().Min(2), z.Bool()).Max(3),
+		"owner": z.Ptr(z.Struct(z.Shape{"id": z.Int().Required()})).NotNil(),
+	})
+	assert.JSONEq(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {
+			"age": {"type": "integer", "minimum": 18},
+			"meta": {
+				"type": "object",
+				"propertyNames": {"type": "string", "minLength": 2},
+				"additionalProperties": {"type": "boolean"},
+				"maxProperties": 3
+			},
+			"name": {"type": "string"},
+			"owner": {"type": "object", "properties": {"id": {"type": "integer"}}, "required": ["id"]},
+			"tags": {"type": "array", "items": {"type": "string"}, "minItems": 1, "maxItems": 5, "contains": {"const": "go"}}
+		},
+		"required": ["name", "owner"]
+	}`, toJSON(t, From(schema)))
+}
+
+func TestUnions(t *testing.T) {
+	assert.JSONEq(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"anyOf": [{"type": "string"}, {"type": "integer"}]
+	}`, toJSON(t, From(z.Union(z.String(), z.Int()))))
+
+	s := From(z.DiscriminatedUnion("type", map[string]*z.StructSchema{
+		"card": z.Struct(z.Shape{"number": z.String()}),
+		"bank": z.Struct(z.Shape{"iban": z.String()}),
+	}))
+	assert.Len(t, s.OneOf, 2)
+	assert.Equal(t, "bank", s.OneOf[0].AllOf[1].Properties["type"].Const)
+	assert.Equal(t, []string{"type"}, s.OneOf[0].AllOf[1].Required)
+}
+
+func TestWhen(t *testing.T) {
+	s := From(z.Struct(z.Shape{
+		"country": z.String(),
+		"vat":     z.When("country", func(c string) bool { return c == "es" }, z.String().Min(9), z.Int()),
+		"note":    z.When("country", func(c string) bool { return c == "es" }, z.String(), nil),
+	}))
+	vat := s.Properties["vat"].AnyOf
+	assert.Len(t, vat, 2)
+	assert.Equal(t, 9, *vat[0].MinLength)
+	assert.Equal(t, "integer", vat[1].Type)
+	assert.Equal(t, &Schema{}, s.Properties["note"])
+}
+
+func TestLiteral(t *testing.T) {
+	s := From(z.Struct(z.Shape{
+		"kind":    z.Literal("Invoice").Required(),
+		"version": z.Literal(2),
+		"draft":   z.Literal(false),
+	}))
+	assert.Equal(t, "Invoice", s.Properties["kind"].Const)
+	assert.Equal(t, 2, s.Properties["version"].Const)
+	assert.JSONEq(t, `{"const":false}`, toJSON(t, s.Properties["draft"]))
+	assert.Equal(t, []string{"kind"}, s.Required)
+}
+
+type level int
+
+func TestEnum(t *testing.T) {
+	s := From(z.Enum("a", "b"))
+	assert.Equal(t, "string", s.Type)
+	assert.Equal(t, []any{"a", "b"}, s.Enum)
+
+	s = From(z.Enum(level(1), level(2)))
+	assert.JSONEq(t, `{"$schema":"https://json-schema.org/draft/2020-12/schema","type":"integer","enum":[1,2]}`, toJSON(t, s))
+
+	// names are accepted as input but are strings so they don't go in the values enum
+	s = From(z.Enum(level(1), level(2)).Names(map[string]level{"LOW": 1, "HIGH": 2}).Default(1))
+	assert.JSONEq(t, `{"$schema":"https://json-schema.org/draft/2020-12/schema","default":1,"anyOf":[{"type":"integer","enum":[1,2]},{"type":"string","enum":["HIGH","LOW"]}]}`, toJSON(t, s))
+}
+
+func TestTuple(t *testing.T) {
+	s := From(z.Tuple(z.Float64().GTE(-180), z.Float64()))
+	assert.JSONEq(t, `{"$schema":"https://json-schema.org/draft/2020-12/schema","type":"array","prefixItems":[{"type":"number","minimum":-180},{"type":"number"}],"minItems":2,"maxItems":2}`, toJSON(t, s))
+
+	s = From(z.Tuple(z.String()).Rest(z.Int()))
+	assert.Equal(t, "integer", s.Items.Type)
+	assert.Equal(t, 1, *s.MinItems)
+	assert.Nil(t, s.MaxItems)
+}
+
+func TestFile(t *testing.T) {
+	s := From(z.Struct(z.Shape{
+		"avatar": z.File().Required().MaxSize(1 << 20),
+		"photos": z.Slice(z.File()),
+	}))
+	assert.JSONEq(t, `{"type":"string","format":"binary"}`, toJSON(t, s.Properties["avatar"]))
+	assert.JSONEq(t, `{"type":"array","items":{"type":"string","format":"binary"}}`, toJSON(t, s.Properties["photos"]))
+}
+
+func TestRecursiveSchema(t *testing.T) {
+	var comment *z.StructSchema
+	comment = z.Struct(z.Shape{
+		"text":    z.String(),
+		"replies": z.Slice(z.Lazy(func() z.ZogSchema { return comment })),
+	})
+	assert.JSONEq(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {
+			"replies": {"type": "array", "items": {"$ref": "#"}},
+			"text": {"type": "string"}
+		}
+	}`, toJSON(t, From(comment)))
+
+	post := z.Struct(z.Shape{"comments": z.Slice(comment)})
+	assert.JSONEq(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {
+			"comments": {"type": "array", "items": {"$ref": "#/$defs/schema1"}}
+		},
+		"$defs": {
+			"schema1": {
+				"type": "object",
+				"properties": {
+					"replies": {"type": "array", "items": {"$ref": "#/$defs/schema1"}},
+					"text": {"type": "string"}
+				}
+			}
+		}
+	}`, toJSON(t, From(post)))
+}
+
+func TestWithStructTags(t *testing.T) {
+	type Address struct {
+		Street string `json:"street_name"`
+	}
+	type User struct {
+		Name      string    `json:"full_name"`
+		Age       int       `zog:"years"`
+		Addresses []Address `json:"addresses"`
+		Email     string
+	}
+	schema := z.Struct(z.Shape{
+		"name":      z.String().Required(),
+		"age":       z.Int(),
+		"email":     z.String(),
+		"addresses": z.Slice(z.Struct(z.Shape{"street": z.String().Required()})),
+	})
+	assert.JSONEq(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {
+			"addresses": {"type": "array", "items": {"type": "object", "properties": {"street_name": {"type": "string"}}, "required": ["street_name"]}},
+			"years": {"type": "integer"},
+			"email": {"type": "string"},
+			"full_name": {"type": "string"}
+		},
+		"required": ["full_name"]
+	}`, toJSON(t, From(schema, WithStructTags(&User{}, "json"))))
+}
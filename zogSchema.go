@@ -1,6 +1,9 @@
 package zog
 
 import (
+	"reflect"
+	"sync"
+
 	p "github.com/Oudwins/zog/internals"
 	"github.com/Oudwins/zog/zconst"
 )
@@ -12,6 +15,9 @@ type ZogSchema interface {
 	validate(ctx *p.SchemaCtx)
 	getType() zconst.ZogType
 	setCoercer(c CoercerFunc)
+	cloneSchema() ZogSchema
+	describe(d *describer) *SchemaDescription
+	Describe() *SchemaDescription
 }
 
 // This is a common interface for all complex schemas (i.e structs, slices, pointers...)
@@ -50,7 +56,26 @@ func TestFunc[T any](IssueCode zconst.ZogIssueCode, fn BoolTFunc[T], options ...
 
 // ! PRIMITIVE PROCESSING -> Not userspace code
 
+// Replaces data providers (i.e zhttp.Request) in ctx.Data with the value they wrap. Used by schemas that don't read fields from the data so they can parse json arrays & primitives. Returns false if the data provider factory failed
+func resolveDataProvider(ctx *p.SchemaCtx) bool {
+	if factory, ok := ctx.Data.(p.DpFactory); ok {
+		dp, err := factory()
+		if err != nil {
+			ctx.AddIssue(ctx.IssueFromUnknownError(err))
+			return false
+		}
+		ctx.Data = dp
+	}
+	if dp, ok := ctx.Data.(p.DataProvider); ok {
+		ctx.Data = dp.GetUnderlying()
+	}
+	return true
+}
+
 func primitiveParsing[T p.ZogPrimitive](ctx *p.SchemaCtx, processors []p.ZProcessor[*T], defaultVal *T, required *p.Test[*T], catch *T, coercer CoercerFunc, isZeroFunc p.IsZeroValueFunc) {
+	if !resolveDataProvider(ctx) {
+		return
+	}
 	ctx.CanCatch = catch != nil
 
 	destPtr, ok := ctx.ValPtr.(*T)
@@ -150,3 +175,189 @@ func primitiveValidation[T p.ZogPrimitive](ctx *p.SchemaCtx, processors []p.ZPro
 		}
 	}
 }
+
+// ! COMPLEX PROCESSING -> Not userspace code
+
+// Runs fn collecting the issues it raises into a separate list. If any issue is raised (at any depth) the destination is set to the catch value and the issues are discarded.
+// This gives complex schemas the same catch semantics as primitiveParsing/primitiveValidation
+func complexCatch(ctx *p.SchemaCtx, catch any, fn func(ctx *p.SchemaCtx)) {
+	errs := p.NewErrsList()
+	defer errs.Free()
+	execCtx := ctx.ExecCtx.Fork(errs)
+	defer execCtx.Free()
+	subCtx := execCtx.NewSchemaCtx(ctx.Data, ctx.ValPtr, ctx.Path, ctx.DType)
+	defer subCtx.Free()
+	subCtx.CanCatch = true
+
+	fn(subCtx)
+	if errs.IsEmpty() && !subCtx.Exit {
+		return
+	}
+	for _, issue := range errs.List {
+		p.FreeIssue(issue)
+	}
+	setValue(ctx.ValPtr, catch)
+	ctx.HasCaught = true
+}
+
+// number of chunks the children are split into per goroutine so that workers that finish early can pick up more work
+const chunksPerWorker = 4
+
+// Runs fn for each of the n children (slice items or struct fields) of a complex schema in order. fn gets a schema context with the path of the parent & must push/pop the child's path itself.
+// If the execution has parallelism (see z.WithParallelism) the children are split into chunks that run concurrently, each with its own path & issue list. The issues are then added to ctx in the same order a sequential execution would add them.
+// Returns false if the execution was cancelled before every child ran
+func eachChild(ctx *p.SchemaCtx, n int, dtype zconst.ZogType, fn func(i int, subCtx *p.SchemaCtx)) bool {
+	workers := ctx.Parallelism()
+	if workers <= 1 || n <= 1 {
+		subCtx := ctx.NewSchemaCtx(nil, ctx.ValPtr, ctx.Path, dtype)
+		defer subCtx.Free()
+		for i := 0; i < n; i++ {
+			if ctx.Stopped() {
+				return false
+			}
+			subCtx.Exit = false
+			fn(i, subCtx)
+		}
+		return true
+	}
+
+	chunks := min(n, workers*chunksPerWorker)
+	errs := make([]*p.ErrsList, chunks)
+	var wg sync.WaitGroup
+	// panics (i.e schema definition errors) are re-raised in the calling goroutine so they behave like in a sequential execution
+	var panicOnce sync.Once
+	var panicVal any
+	for c := 0; c < chunks; c++ {
+		errs[c] = p.NewErrsList()
+		start, end := c*n/chunks, (c+1)*n/chunks
+		run := func(errs *p.ErrsList) {
+			execCtx := ctx.ExecCtx.ForkWorker(errs)
+			defer execCtx.Free()
+			path := ctx.Path.Clone()
+			defer path.Free()
+			subCtx := execCtx.NewSchemaCtx(nil, ctx.ValPtr, path, dtype)
+			defer subCtx.Free()
+			for i := start; i < end; i++ {
+				if subCtx.Stopped() {
+					return
+				}
+				subCtx.Exit = false
+				fn(i, subCtx)
+			}
+		}
+		if ctx.TryAcquireWorker() {
+			wg.Add(1)
+			go func(errs *p.ErrsList) {
+				defer wg.Done()
+				defer ctx.ReleaseWorker()
+				defer func() {
+					if r := recover(); r != nil {
+						panicOnce.Do(func() { panicVal = r })
+					}
+				}()
+				run(errs)
+			}(errs[c])
+		} else {
+			run(errs[c])
+		}
+	}
+	wg.Wait()
+	if panicVal != nil {
+		panic(panicVal)
+	}
+
+	var marker *p.ZogIssue
+	for _, chunkErrs := range errs {
+		for _, issue := range chunkErrs.List {
+			if issue.Code == zconst.IssueCodeMaxIssues {
+				// the marker may come from any chunk. It is added last so it always follows the kept issues
+				marker = issue
+				continue
+			}
+			ctx.ExecCtx.Errors.Add(issue)
+		}
+		chunkErrs.Free()
+	}
+	if marker != nil {
+		ctx.ExecCtx.Errors.Add(marker)
+	}
+	return !ctx.Stopped()
+}
+
+// sets the value pointed to by destPtr to a deep copy of val. Used for default & catch values so changing the destination doesn't change the schema
+func setValue(destPtr any, val any) {
+	reflect.ValueOf(destPtr).Elem().Set(deepCopy(reflect.ValueOf(val)))
+}
+
+// returns a copy of v that shares no slices, maps or pointers with it. Unexported struct fields are copied as is
+func deepCopy(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(deepCopy(v.Index(i)))
+		}
+		return c
+	case reflect.Array:
+		c := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(deepCopy(v.Index(i)))
+		}
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			c.SetMapIndex(iter.Key(), deepCopy(iter.Value()))
+		}
+		return c
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(deepCopy(v.Elem()))
+		return c
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(deepCopy(v.Elem()))
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if c.Field(i).CanSet() {
+				c.Field(i).Set(deepCopy(v.Field(i)))
+			}
+		}
+		return c
+	default:
+		return v
+	}
+}
+
+// returns a deep copy of a default or catch value of a complex schema or nil if it is nil
+func cloneValue(val any) any {
+	if val == nil {
+		return nil
+	}
+	return deepCopy(reflect.ValueOf(val)).Interface()
+}
+
+// returns a pointer to a copy of the value or nil if the pointer is nil
+func clonePtr[T any](v *T) *T {
+	if v == nil {
+		return nil
+	}
+	c := *v
+	return &c
+}